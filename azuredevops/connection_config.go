@@ -12,6 +12,7 @@ import (
 type azureDevOpsConfig struct {
 	OrganizationURL     *string `hcl:"organization_url"`
 	PersonalAccessToken *string `hcl:"personal_access_token"`
+	RedactValues        *bool   `hcl:"redact_values"`
 }
 
 func ConfigInstance() interface{} {
@@ -48,3 +49,10 @@ func getConnection(ctx context.Context, d *plugin.QueryData) (*azuredevops.Conne
 
 	return nil, errors.New("'organization_url' and 'personal_access_token' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe.")
 }
+
+// shouldRedactValues returns true if the values of variable group variables
+// must not be returned, even when they are not secret.
+func shouldRedactValues(d *plugin.QueryData) bool {
+	azureDevOpsConfig := GetConfig(d.Connection)
+	return azureDevOpsConfig.RedactValues != nil && *azureDevOpsConfig.RedactValues
+}
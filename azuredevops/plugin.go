@@ -22,19 +22,49 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			},
 		},
 		TableMap: map[string]*plugin.Table{
-			"azuredevops_build":                 tableAzureDevOpsBuild(ctx),
-			"azuredevops_build_definition":      tableAzureDevOpsBuildDefinition(ctx),
-			"azuredevops_dashboard":             tableAzureDevOpsDashboard(ctx),
-			"azuredevops_git_repository":        tableAzureDevOpsGitRepository(ctx),
-			"azuredevops_git_repository_branch": tableAzureDevOpsGitRepositoryBranch(ctx),
-			"azuredevops_group":                 tableAzureDevOpsGroup(ctx),
-			"azuredevops_pipeline":              tableAzureDevOpsPipeline(ctx),
-			"azuredevops_project":               tableAzureDevOpsProject(ctx),
-			"azuredevops_release":               tableAzureDevOpsRelease(ctx),
-			"azuredevops_serviceendpoint":       tableAzureDevOpsServiceEndpoint(ctx),
-			"azuredevops_team":                  tableAzureDevOpsTeam(ctx),
-			"azuredevops_team_member":           tableAzureDevOpsTeamMember(ctx),
-			"azuredevops_user":                  tableAzureDevOpsUser(ctx),
+			"azuredevops_build":                        tableAzureDevOpsBuild(ctx),
+			"azuredevops_build_artifact":               tableAzureDevOpsBuildArtifact(ctx),
+			"azuredevops_build_change":                 tableAzureDevOpsBuildChange(ctx),
+			"azuredevops_build_definition":             tableAzureDevOpsBuildDefinition(ctx),
+			"azuredevops_build_definition_metrics":     tableAzureDevOpsBuildDefinitionMetrics(ctx),
+			"azuredevops_build_log":                    tableAzureDevOpsBuildLog(ctx),
+			"azuredevops_build_log_line":               tableAzureDevOpsBuildLogLine(ctx),
+			"azuredevops_build_timeline_record":        tableAzureDevOpsBuildTimelineRecord(ctx),
+			"azuredevops_build_work_item":              tableAzureDevOpsBuildWorkItem(ctx),
+			"azuredevops_check_configuration":          tableAzureDevOpsCheckConfiguration(ctx),
+			"azuredevops_dashboard":                    tableAzureDevOpsDashboard(ctx),
+			"azuredevops_environment":                  tableAzureDevOpsEnvironment(ctx),
+			"azuredevops_environment_deployment":       tableAzureDevOpsEnvironmentDeployment(ctx),
+			"azuredevops_environment_resource":         tableAzureDevOpsEnvironmentResource(ctx),
+			"azuredevops_git_commit":                   tableAzureDevOpsGitCommit(ctx),
+			"azuredevops_git_commit_change":            tableAzureDevOpsGitCommitChange(ctx),
+			"azuredevops_git_deleted_repository":       tableAzureDevOpsGitDeletedRepository(ctx),
+			"azuredevops_git_import_request":           tableAzureDevOpsGitImportRequest(ctx),
+			"azuredevops_git_item":                     tableAzureDevOpsGitItem(ctx),
+			"azuredevops_git_push":                     tableAzureDevOpsGitPush(ctx),
+			"azuredevops_git_ref":                      tableAzureDevOpsGitRef(ctx),
+			"azuredevops_git_repository":               tableAzureDevOpsGitRepository(ctx),
+			"azuredevops_git_repository_branch":        tableAzureDevOpsGitRepositoryBranch(ctx),
+			"azuredevops_git_repository_fork":          tableAzureDevOpsGitRepositoryFork(ctx),
+			"azuredevops_group":                        tableAzureDevOpsGroup(ctx),
+			"azuredevops_pipeline":                     tableAzureDevOpsPipeline(ctx),
+			"azuredevops_pipeline_approval":            tableAzureDevOpsPipelineApproval(ctx),
+			"azuredevops_pipeline_preview":             tableAzureDevOpsPipelinePreview(ctx),
+			"azuredevops_pipeline_resource_permission": tableAzureDevOpsPipelineResourcePermission(ctx),
+			"azuredevops_pipeline_run":                 tableAzureDevOpsPipelineRun(ctx),
+			"azuredevops_pipeline_run_artifact":        tableAzureDevOpsPipelineRunArtifact(ctx),
+			"azuredevops_pipeline_run_log":             tableAzureDevOpsPipelineRunLog(ctx),
+			"azuredevops_pipeline_yaml":                tableAzureDevOpsPipelineYaml(ctx),
+			"azuredevops_policy_configuration":         tableAzureDevOpsPolicyConfiguration(ctx),
+			"azuredevops_project":                      tableAzureDevOpsProject(ctx),
+			"azuredevops_release":                      tableAzureDevOpsRelease(ctx),
+			"azuredevops_serviceendpoint":              tableAzureDevOpsServiceEndpoint(ctx),
+			"azuredevops_team":                         tableAzureDevOpsTeam(ctx),
+			"azuredevops_team_member":                  tableAzureDevOpsTeamMember(ctx),
+			"azuredevops_user":                         tableAzureDevOpsUser(ctx),
+			"azuredevops_variable_group":               tableAzureDevOpsVariableGroup(ctx),
+			"azuredevops_variable_group_variable":      tableAzureDevOpsVariableGroupVariable(ctx),
+			"azuredevops_variable_secret_finding":      tableAzureDevOpsVariableSecretFinding(ctx),
 		},
 	}
 	return p
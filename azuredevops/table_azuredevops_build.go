@@ -2,11 +2,17 @@ package azuredevops
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
@@ -27,6 +33,12 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				{Name: "result", Require: plugin.Optional},
 				{Name: "repository_id", Require: plugin.Optional},
 				{Name: "repository_type", Require: plugin.Optional},
+				{Name: "definition_id", Require: plugin.Optional},
+				{Name: "requested_for_id", Require: plugin.Optional},
+				{Name: "source_branch", Require: plugin.Optional},
+				{Name: "tag_filters", Require: plugin.Optional},
+				{Name: "queue_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+				{Name: "finish_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -50,6 +62,24 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Project.Id"),
 			},
+			{
+				Name:        "definition_id",
+				Description: "The ID of the definition associated with the build.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Definition.Id"),
+			},
+			{
+				Name:        "requested_for_id",
+				Description: "The ID of the identity on whose behalf the build was queued.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestedFor.Id"),
+			},
+			{
+				Name:        "tag_filters",
+				Description: "A comma-delimited list of tags. If specified, only builds with all of the tags are returned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("tag_filters"),
+			},
 			{
 				Name:        "quality",
 				Description: "The quality of the xaml build (good, bad, etc.).",
@@ -167,6 +197,18 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("StartTime.Time"),
 			},
+			{
+				Name:        "duration_seconds",
+				Description: "The time in seconds between the start and finish time of the build.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(buildDurationSeconds),
+			},
+			{
+				Name:        "queue_wait_seconds",
+				Description: "The time in seconds the build waited in the queue before it started.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(buildQueueWaitSeconds),
+			},
 			{
 				Name:        "uri",
 				Description: "The URI of the build.",
@@ -343,9 +385,30 @@ func listBuilds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	if d.EqualsQuals["repository_type"] != nil {
 		input.RepositoryType = types.String(d.EqualsQuals["repository_type"].GetStringValue())
 	}
-	if d.EqualsQuals["id"] != nil {
-		id := []int{int(d.EqualsQuals["id"].GetInt64Value())}
-		input.BuildIds = &id
+	if d.EqualsQuals["requested_for_id"] != nil {
+		input.RequestedFor = types.String(d.EqualsQuals["requested_for_id"].GetStringValue())
+	}
+	if d.EqualsQuals["source_branch"] != nil {
+		input.BranchName = types.String(d.EqualsQuals["source_branch"].GetStringValue())
+	}
+	if d.EqualsQuals["tag_filters"] != nil {
+		tags := strings.Split(d.EqualsQuals["tag_filters"].GetStringValue(), ",")
+		input.TagFilters = &tags
+	}
+	setBuildTimeFilter(d, &input)
+
+	// The SDK calls listBuilds once per value of an "in (...)" qual, so fetch the
+	// whole list in one batch per project and stream the builds of this call
+	for _, column := range []string{"id", "definition_id"} {
+		if d.EqualsQuals[column] == nil {
+			continue
+		}
+		values := getQualIntValues(d.EqualsQuals[column])
+		batch := getBuildsQualBatchValues(d, column)
+		setBuildsQualValues(&input, column, batch)
+		if len(batch) > len(values) {
+			return streamBuildsBatch(ctx, d, buildsBatch{ProjectId: project.Id.String(), Column: column, Input: input}, values)
+		}
 	}
 
 	for {
@@ -372,6 +435,160 @@ func listBuilds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	return nil, nil
 }
 
+// buildsBatch is a GetBuilds call shared by the calls of an "in (...)" qual.
+type buildsBatch struct {
+	ProjectId string
+	Column    string
+	Input     build.GetBuildsArgs
+}
+
+// getBuildsQualBatchValues returns all the values of the "in (...)" qual the
+// value of the column was split from, or else the values of the column.
+func getBuildsQualBatchValues(d *plugin.QueryData, column string) []int {
+	values := getQualIntValues(d.EqualsQuals[column])
+	if len(values) != 1 || d.QueryContext == nil || d.QueryContext.UnsafeQuals[column] == nil {
+		return values
+	}
+
+	for _, q := range d.QueryContext.UnsafeQuals[column].Quals {
+		if q.GetStringValue() != "=" || q.Value.GetListValue() == nil {
+			continue
+		}
+		batch := getQualIntValues(q.Value)
+		if slices.Contains(batch, values[0]) {
+			slices.Sort(batch)
+			return slices.Compact(batch)
+		}
+	}
+	return values
+}
+
+// setBuildsQualValues pushes the values of the id or definition_id qual into the input.
+func setBuildsQualValues(input *build.GetBuildsArgs, column string, values []int) {
+	switch column {
+	case "id":
+		input.BuildIds = &values
+	case "definition_id":
+		input.Definitions = &values
+	}
+}
+
+// buildQualValue returns the value of the id or definition_id column of the build.
+func buildQualValue(b build.Build, column string) int {
+	switch column {
+	case "id":
+		return types.IntValue(b.Id)
+	case "definition_id":
+		if b.Definition != nil {
+			return types.IntValue(b.Definition.Id)
+		}
+	}
+	return 0
+}
+
+// streamBuildsBatch streams the builds of the batch that match the values of this call.
+func streamBuildsBatch(ctx context.Context, d *plugin.QueryData, batch buildsBatch, values []int) (interface{}, error) {
+	builds, err := listBuildsBatch(ctx, d, &plugin.HydrateData{Item: batch})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range builds.([]build.Build) {
+		if !slices.Contains(values, buildQualValue(b, batch.Column)) {
+			continue
+		}
+		d.StreamListItem(ctx, b)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// The batch is memoized per query, so that the calls of an "in (...)" qual share a single request per project.
+var listBuildsBatchMemoized = plugin.HydrateFunc(listBuildsBatchUncached).Memoize(memoize.WithCacheKeyFunction(getBuildsBatchCacheKey), memoize.WithTtl(time.Minute))
+
+func listBuildsBatch(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return listBuildsBatchMemoized(ctx, d, h)
+}
+
+// getBuildsBatchCacheKey builds a cache key for the batch, per query, project and values.
+func getBuildsBatchCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	batch := h.Item.(buildsBatch)
+	values := batch.Input.BuildIds
+	if batch.Column == "definition_id" {
+		values = batch.Input.Definitions
+	}
+	return fmt.Sprintf("listBuildsBatch-%p-%s-%s-%v", d.QueryContext, batch.ProjectId, batch.Column, *values), nil
+}
+
+func listBuildsBatchUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	batch := h.Item.(buildsBatch)
+	input := batch.Input
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build.listBuildsBatchUncached", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build.listBuildsBatchUncached", "client_error", err)
+		return nil, err
+	}
+
+	// The batch is shared by the calls of the qual, so it is not limited
+	input.Top = types.Int(1000)
+
+	var builds []build.Build
+	for {
+		result, err := client.GetBuilds(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_build.listBuildsBatchUncached", "api_error", err)
+			return nil, err
+		}
+		builds = append(builds, result.Value...)
+
+		input.ContinuationToken = types.String(result.ContinuationToken)
+		if result.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return builds, nil
+}
+
+// setBuildTimeFilter pushes the finish_time, or else the queue_time, quals into
+// MinTime and MaxTime. The API applies them to the time the results are ordered by.
+func setBuildTimeFilter(d *plugin.QueryData, input *build.GetBuildsArgs) {
+	column := "finish_time"
+	queryOrder := build.BuildQueryOrderValues.FinishTimeDescending
+	if d.Quals[column] == nil {
+		column = "queue_time"
+		queryOrder = build.BuildQueryOrderValues.QueueTimeDescending
+	}
+	if d.Quals[column] == nil {
+		return
+	}
+
+	for _, q := range d.Quals[column].Quals {
+		value := &azuredevops.Time{Time: q.Value.GetTimestampValue().AsTime()}
+		switch q.Operator {
+		case ">", ">=":
+			input.MinTime = value
+		case "<", "<=":
+			input.MaxTime = value
+		case "=":
+			input.MinTime = value
+			input.MaxTime = value
+		}
+	}
+	input.QueryOrder = &queryOrder
+}
+
 func getBuild(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	buildId := d.EqualsQuals["id"].GetInt64Value()
 	projectId := d.EqualsQuals["project_id"].GetStringValue()
@@ -405,3 +622,33 @@ func getBuild(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 
 	return build, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+// buildFromItem returns the build of the row, which is a value when listed and
+// a pointer when fetched with getBuild.
+func buildFromItem(item interface{}) build.Build {
+	switch b := item.(type) {
+	case build.Build:
+		return b
+	case *build.Build:
+		return *b
+	}
+	return build.Build{}
+}
+
+func buildDurationSeconds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	b := buildFromItem(d.HydrateItem)
+	if b.StartTime == nil || b.FinishTime == nil {
+		return nil, nil
+	}
+	return b.FinishTime.Time.Sub(b.StartTime.Time).Seconds(), nil
+}
+
+func buildQueueWaitSeconds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	b := buildFromItem(d.HydrateItem)
+	if b.QueueTime == nil || b.StartTime == nil {
+		return nil, nil
+	}
+	return b.StartTime.Time.Sub(b.QueueTime.Time).Seconds(), nil
+}
@@ -0,0 +1,162 @@
+package azuredevops
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsBuildArtifact(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_artifact",
+		Description: "Retrieve information about the artifacts published by your builds.",
+		List: &plugin.ListConfig{
+			Hydrate: listBuildArtifacts,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "build_id", Require: plugin.Required},
+				{Name: "project_id", Require: plugin.Required},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the artifact.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the artifact.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The artifact source, which will be the ID of the job that produced this artifact.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource, e.g. Container or FilePath.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Type"),
+			},
+			{
+				Name:        "download_url",
+				Description: "A link to download the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.DownloadUrl"),
+			},
+			{
+				Name:        "data",
+				Description: "Type-specific data about the artifact, e.g. the container or file path.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Data"),
+			},
+			{
+				Name:        "size",
+				Description: "The size of the artifact in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "url",
+				Description: "The full http link to the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Url"),
+			},
+			{
+				Name:        "properties",
+				Description: "Type-specific properties of the artifact.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Resource.Properties"),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type BuildArtifact struct {
+	build.BuildArtifact
+	BuildId   int
+	ProjectId string
+	Size      *int64
+}
+
+func listBuildArtifacts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	buildId := int(d.EqualsQuals["build_id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_artifact.listBuildArtifacts", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_artifact.listBuildArtifacts", "client_error", err)
+		return nil, err
+	}
+
+	input := build.GetArtifactsArgs{
+		Project: types.String(projectId),
+		BuildId: types.Int(buildId),
+	}
+
+	artifacts, err := client.GetArtifacts(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_artifact.listBuildArtifacts", "api_error", err)
+		return nil, err
+	}
+
+	for _, artifact := range *artifacts {
+		d.StreamListItem(ctx, BuildArtifact{artifact, buildId, projectId, getBuildArtifactSize(artifact)})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getBuildArtifactSize returns the size reported in the artifact properties, if any.
+func getBuildArtifactSize(artifact build.BuildArtifact) *int64 {
+	if artifact.Resource == nil || artifact.Resource.Properties == nil {
+		return nil
+	}
+	value, ok := (*artifact.Resource.Properties)["artifactsize"]
+	if !ok {
+		return nil
+	}
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &size
+}
@@ -0,0 +1,163 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsBuildChange(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_change",
+		Description: "Retrieve information about the changes (commits) associated with your builds.",
+		List: &plugin.ListConfig{
+			Hydrate: listBuildChanges,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "build_id", Require: plugin.Required},
+				{Name: "project_id", Require: plugin.Required},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The identifier of the change, e.g. the commit ID for Git changes.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the change, e.g. TfsGit or GitHub.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message",
+				Description: "The description of the change, which may include a commit message.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message_truncated",
+				Description: "Indicates whether the message was truncated.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "author_name",
+				Description: "The display name of the author of the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Author.DisplayName"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The timestamp of the change.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timestamp.Time"),
+			},
+			{
+				Name:        "pusher",
+				Description: "The person or process that pushed the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_uri",
+				Description: "The location of a user-friendly representation of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The location of the full representation of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "author",
+				Description: "The author of the change.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type BuildChange struct {
+	build.Change
+	BuildId   int
+	ProjectId string
+}
+
+func listBuildChanges(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	buildId := int(d.EqualsQuals["build_id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_change.listBuildChanges", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_change.listBuildChanges", "client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	input := build.GetBuildChangesArgs{
+		Project:             types.String(projectId),
+		BuildId:             types.Int(buildId),
+		Top:                 types.Int(maxLimit),
+		IncludeSourceChange: types.Bool(true),
+	}
+
+	for {
+		changes, err := client.GetBuildChanges(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_build_change.listBuildChanges", "api_error", err)
+			return nil, err
+		}
+
+		for _, change := range changes.Value {
+			d.StreamListItem(ctx, BuildChange{change, buildId, projectId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		input.ContinuationToken = types.String(changes.ContinuationToken)
+		if changes.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
@@ -3,6 +3,7 @@ package azuredevops
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
 	"github.com/turbot/go-kit/types"
@@ -11,6 +12,9 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+// The location of the definitions resource of the Build API
+var buildDefinitionsLocationId = uuid.MustParse("dbeaf647-6167-421a-bda9-c9327b25e2e6")
+
 func tableAzureDevOpsBuildDefinition(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "azuredevops_build_definition",
@@ -0,0 +1,369 @@
+package azuredevops
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The number of most recent completed builds sampled per definition
+const definitionMetricsSampleSize = 1000
+
+// The window used when no window_start is provided
+const definitionMetricsDefaultWindow = 7 * 24 * time.Hour
+
+func tableAzureDevOpsBuildDefinitionMetrics(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_definition_metrics",
+		Description: "Retrieve success rate, duration and failure streak metrics of your build definitions.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listBuildDefinitionMetrics,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "definition_id", Require: plugin.Optional},
+				{Name: "window_start", Require: plugin.Optional, Operators: []string{"=", ">=", ">"}},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "definition_id",
+				Description: "The ID of the definition.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "definition_name",
+				Description: "The name of the definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "window_start",
+				Description: "The start of the window the metrics are computed over. Defaults to 7 days ago.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "total_builds",
+				Description: "The number of builds completed in the window, up to 1000 most recent.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "succeeded_builds",
+				Description: "The number of builds that succeeded.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "partially_succeeded_builds",
+				Description: "The number of builds that partially succeeded.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "failed_builds",
+				Description: "The number of builds that failed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "canceled_builds",
+				Description: "The number of builds that were canceled.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "success_rate",
+				Description: "The percentage of succeeded builds among the builds that succeeded, partially succeeded or failed.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "p50_duration_seconds",
+				Description: "The median duration of the builds, in seconds.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "p95_duration_seconds",
+				Description: "The 95th percentile duration of the builds, in seconds.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "current_failure_streak",
+				Description: "The number of consecutive failed builds up to the most recent build.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "longest_failure_streak",
+				Description: "The longest number of consecutive failed builds in the window.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_build_finish_time",
+				Description: "The finish time of the most recent build in the window.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "metrics",
+				Description: "The metrics reported by Azure DevOps for the definition since the start of the window.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBuildDefinitionMetrics,
+				Transform:   transform.FromValue(),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DefinitionName"),
+			},
+		}),
+	}
+}
+
+type BuildDefinitionMetrics struct {
+	DefinitionId             int
+	DefinitionName           *string
+	ProjectId                string
+	WindowStart              time.Time
+	TotalBuilds              int
+	SucceededBuilds          int
+	PartiallySucceededBuilds int
+	FailedBuilds             int
+	CanceledBuilds           int
+	SuccessRate              *float64
+	P50DurationSeconds       *float64
+	P95DurationSeconds       *float64
+	CurrentFailureStreak     int
+	LongestFailureStreak     int
+	LastBuildFinishTime      *time.Time
+}
+
+func listBuildDefinitionMetrics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_definition_metrics.listBuildDefinitionMetrics", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_definition_metrics.listBuildDefinitionMetrics", "client_error", err)
+		return nil, err
+	}
+
+	windowStart := getBuildDefinitionMetricsWindowStart(d)
+
+	input := build.GetDefinitionsArgs{
+		Project: types.String(project.Id.String()),
+	}
+	if d.EqualsQuals["definition_id"] != nil {
+		ids := getQualIntValues(d.EqualsQuals["definition_id"])
+		input.DefinitionIds = &ids
+	}
+
+	for {
+		definitions, err := client.GetDefinitions(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_build_definition_metrics.listBuildDefinitionMetrics", "api_error", err)
+			return nil, err
+		}
+
+		for _, definition := range definitions.Value {
+			builds, err := listBuildDefinitionMetricsSample(ctx, client, project.Id.String(), *definition.Id, windowStart)
+			if err != nil {
+				plugin.Logger(ctx).Error("azuredevops_build_definition_metrics.listBuildDefinitionMetrics", "api_error", err)
+				return nil, err
+			}
+
+			metrics := newBuildDefinitionMetrics(builds)
+			metrics.DefinitionId = *definition.Id
+			metrics.DefinitionName = definition.Name
+			metrics.ProjectId = project.Id.String()
+			metrics.WindowStart = windowStart
+			d.StreamListItem(ctx, metrics)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		input.ContinuationToken = types.String(definitions.ContinuationToken)
+		if definitions.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+// getBuildDefinitionMetricsWindowStart returns the start of the window from the
+// window_start quals. For ">" the window starts just after the given time, so
+// that the window_start of the rows still satisfies the qual.
+func getBuildDefinitionMetricsWindowStart(d *plugin.QueryData) time.Time {
+	if d.Quals["window_start"] == nil {
+		return time.Now().Add(-definitionMetricsDefaultWindow)
+	}
+
+	var windowStart time.Time
+	for _, q := range d.Quals["window_start"].Quals {
+		value := q.Value.GetTimestampValue().AsTime()
+		if q.Operator == ">" {
+			value = value.Add(time.Microsecond)
+		}
+		if value.After(windowStart) {
+			windowStart = value
+		}
+	}
+
+	return windowStart
+}
+
+// listBuildDefinitionMetricsSample returns the most recent completed builds of
+// the definition finished since windowStart, most recent first.
+func listBuildDefinitionMetricsSample(ctx context.Context, client build.Client, projectId string, definitionId int, windowStart time.Time) ([]build.Build, error) {
+	input := build.GetBuildsArgs{
+		Project:      types.String(projectId),
+		Definitions:  &[]int{definitionId},
+		StatusFilter: &build.BuildStatusValues.Completed,
+		MinTime:      &azuredevops.Time{Time: windowStart},
+		QueryOrder:   &build.BuildQueryOrderValues.FinishTimeDescending,
+		Top:          types.Int(definitionMetricsSampleSize),
+	}
+
+	var builds []build.Build
+	for {
+		response, err := client.GetBuilds(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		builds = append(builds, response.Value...)
+		if len(builds) >= definitionMetricsSampleSize || response.ContinuationToken == "" {
+			break
+		}
+		input.ContinuationToken = types.String(response.ContinuationToken)
+	}
+	if len(builds) > definitionMetricsSampleSize {
+		builds = builds[:definitionMetricsSampleSize]
+	}
+
+	return builds, nil
+}
+
+// newBuildDefinitionMetrics computes the metrics of builds ordered most recent first.
+func newBuildDefinitionMetrics(builds []build.Build) BuildDefinitionMetrics {
+	metrics := BuildDefinitionMetrics{TotalBuilds: len(builds)}
+
+	var durations []float64
+	streak := 0
+	currentStreakEnded := false
+	for _, b := range builds {
+		if metrics.LastBuildFinishTime == nil && b.FinishTime != nil {
+			finishTime := b.FinishTime.Time
+			metrics.LastBuildFinishTime = &finishTime
+		}
+		if b.StartTime != nil && b.FinishTime != nil {
+			durations = append(durations, b.FinishTime.Time.Sub(b.StartTime.Time).Seconds())
+		}
+
+		var result build.BuildResult
+		if b.Result != nil {
+			result = *b.Result
+		}
+		switch result {
+		case build.BuildResultValues.Succeeded:
+			metrics.SucceededBuilds++
+		case build.BuildResultValues.PartiallySucceeded:
+			metrics.PartiallySucceededBuilds++
+		case build.BuildResultValues.Failed:
+			metrics.FailedBuilds++
+		case build.BuildResultValues.Canceled:
+			metrics.CanceledBuilds++
+		}
+
+		// Canceled builds neither extend nor break a failure streak
+		if result == build.BuildResultValues.Canceled {
+			continue
+		}
+		if result == build.BuildResultValues.Failed {
+			streak++
+			if streak > metrics.LongestFailureStreak {
+				metrics.LongestFailureStreak = streak
+			}
+			if !currentStreakEnded {
+				metrics.CurrentFailureStreak = streak
+			}
+			continue
+		}
+		streak = 0
+		currentStreakEnded = true
+	}
+
+	if rated := metrics.SucceededBuilds + metrics.PartiallySucceededBuilds + metrics.FailedBuilds; rated > 0 {
+		rate := float64(metrics.SucceededBuilds) * 100 / float64(rated)
+		metrics.SuccessRate = &rate
+	}
+	metrics.P50DurationSeconds = percentile(durations, 50)
+	metrics.P95DurationSeconds = percentile(durations, 95)
+
+	return metrics
+}
+
+// percentile returns the nearest-rank percentile of the values, or nil if there are none.
+func percentile(values []float64, p float64) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	value := sorted[rank-1]
+	return &value
+}
+
+func getBuildDefinitionMetrics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	metrics := h.Item.(BuildDefinitionMetrics)
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_definition_metrics.getBuildDefinitionMetrics", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_definition_metrics.getBuildDefinitionMetrics", "client_error", err)
+		return nil, err
+	}
+
+	input := build.GetDefinitionMetricsArgs{
+		Project:        types.String(metrics.ProjectId),
+		DefinitionId:   types.Int(metrics.DefinitionId),
+		MinMetricsTime: &azuredevops.Time{Time: metrics.WindowStart},
+	}
+
+	definitionMetrics, err := client.GetDefinitionMetrics(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_definition_metrics.getBuildDefinitionMetrics", "api_error", err)
+		return nil, err
+	}
+
+	return definitionMetrics, nil
+}
@@ -0,0 +1,126 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsBuildLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_log",
+		Description: "Retrieve information about the logs of your builds.",
+		List: &plugin.ListConfig{
+			Hydrate: listBuildLogs,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "build_id", Require: plugin.Required},
+				{Name: "project_id", Require: plugin.Required},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the log.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the log location.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "line_count",
+				Description: "The number of lines in the log.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "created_on",
+				Description: "The date and time the log was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "last_changed_on",
+				Description: "The date and time the log was last changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastChangedOn.Time"),
+			},
+			{
+				Name:        "url",
+				Description: "A full link to the log resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type BuildLog struct {
+	build.BuildLog
+	BuildId   int
+	ProjectId string
+}
+
+func listBuildLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	buildId := int(d.EqualsQuals["build_id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_log.listBuildLogs", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_log.listBuildLogs", "client_error", err)
+		return nil, err
+	}
+
+	input := build.GetBuildLogsArgs{
+		Project: types.String(projectId),
+		BuildId: types.Int(buildId),
+	}
+
+	logs, err := client.GetBuildLogs(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_log.listBuildLogs", "api_error", err)
+		return nil, err
+	}
+
+	for _, log := range *logs {
+		d.StreamListItem(ctx, BuildLog{log, buildId, projectId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
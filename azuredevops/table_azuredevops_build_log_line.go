@@ -0,0 +1,146 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsBuildLogLine(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_log_line",
+		Description: "Retrieve the lines of the logs of your builds.",
+		List: &plugin.ListConfig{
+			Hydrate: listBuildLogLines,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "build_id", Require: plugin.Required},
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "log_id", Require: plugin.Required},
+				{Name: "start_line", Require: plugin.Optional},
+				{Name: "end_line", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_id",
+				Description: "The ID of the log.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "line_number",
+				Description: "The number of the line in the log, starting at 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "line",
+				Description: "The content of the line.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_line",
+				Description: "The first line to fetch.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("start_line"),
+			},
+			{
+				Name:        "end_line",
+				Description: "The last line to fetch.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("end_line"),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LineNumber"),
+			},
+		}),
+	}
+}
+
+type BuildLogLine struct {
+	BuildId    int
+	ProjectId  string
+	LogId      int
+	LineNumber int
+	Line       string
+}
+
+func listBuildLogLines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	buildId := int(d.EqualsQuals["build_id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	logId := int(d.EqualsQuals["log_id"].GetInt64Value())
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_log_line.listBuildLogLines", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_log_line.listBuildLogLines", "client_error", err)
+		return nil, err
+	}
+
+	input := build.GetBuildLogLinesArgs{
+		Project: types.String(projectId),
+		BuildId: types.Int(buildId),
+		LogId:   types.Int(logId),
+	}
+
+	// Lines are numbered from 1, and the returned range starts at start_line
+	lineNumber := 1
+	if d.EqualsQuals["start_line"] != nil {
+		startLine := d.EqualsQuals["start_line"].GetInt64Value()
+		if startLine > 1 {
+			input.StartLine = types.Uint64(uint64(startLine))
+			lineNumber = int(startLine)
+		}
+	}
+	if d.EqualsQuals["end_line"] != nil {
+		endLine := d.EqualsQuals["end_line"].GetInt64Value()
+		if endLine < int64(lineNumber) {
+			return nil, nil
+		}
+		input.EndLine = types.Uint64(uint64(endLine))
+	}
+
+	lines, err := client.GetBuildLogLines(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_log_line.listBuildLogLines", "api_error", err)
+		return nil, err
+	}
+
+	for _, line := range *lines {
+		d.StreamListItem(ctx, BuildLogLine{buildId, projectId, logId, lineNumber, line})
+		lineNumber++
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
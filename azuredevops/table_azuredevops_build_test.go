@@ -0,0 +1,49 @@
+package azuredevops
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func TestGetBuildsQualBatchValues(t *testing.T) {
+	intValue := func(v int64) *proto.QualValue {
+		return &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: v}}
+	}
+	listValue := func(values ...int64) *proto.QualValue {
+		list := &proto.QualValueList{}
+		for _, v := range values {
+			list.Values = append(list.Values, intValue(v))
+		}
+		return &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: list}}
+	}
+	equalsQual := func(column string, value *proto.QualValue) *proto.Qual {
+		return &proto.Qual{FieldName: column, Operator: &proto.Qual_StringValue{StringValue: "="}, Value: value}
+	}
+
+	tests := []struct {
+		name   string
+		equals *proto.QualValue
+		unsafe []*proto.Qual
+		want   []int
+	}{
+		{"single value", intValue(7), []*proto.Qual{equalsQual("id", intValue(7))}, []int{7}},
+		{"split from list", intValue(102), []*proto.Qual{equalsQual("id", listValue(103, 101, 102, 101))}, []int{101, 102, 103}},
+		{"list not split", listValue(101, 102), []*proto.Qual{equalsQual("id", listValue(101, 102))}, []int{101, 102}},
+		{"value not in list", intValue(7), []*proto.Qual{equalsQual("id", listValue(101, 102))}, []int{7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &plugin.QueryData{
+				EqualsQuals:  map[string]*proto.QualValue{"id": tt.equals},
+				QueryContext: &plugin.QueryContext{UnsafeQuals: map[string]*proto.Quals{"id": {Quals: tt.unsafe}}},
+			}
+			if got := getBuildsQualBatchValues(d, "id"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getBuildsQualBatchValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
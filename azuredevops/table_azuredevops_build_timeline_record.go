@@ -0,0 +1,269 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsBuildTimelineRecord(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_timeline_record",
+		Description: "Retrieve information about the stages, phases, jobs and tasks of your builds.",
+		List: &plugin.ListConfig{
+			Hydrate: listBuildTimelineRecords,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "build_id", Require: plugin.Required},
+				{Name: "project_id", Require: plugin.Required},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the record.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_id",
+				Description: "The ID of the record's parent.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the record, e.g. Stage, Phase, Job, Task or Checkpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the record.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "identifier",
+				Description: "String identifier that is consistent across attempts.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "order",
+				Description: "An ordinal value relative to other records.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the record. Possible values are: pending, inProgress, completed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "result",
+				Description: "The result of the record. Possible values are: succeeded, succeededWithIssues, failed, canceled, skipped, abandoned.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "result_code",
+				Description: "The result code.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the record.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime.Time"),
+			},
+			{
+				Name:        "finish_time",
+				Description: "The finish time of the record.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FinishTime.Time"),
+			},
+			{
+				Name:        "duration_seconds",
+				Description: "The time in seconds between the start and finish time of the record.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "worker_name",
+				Description: "The name of the agent running the operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "queue_id",
+				Description: "The ID of the queue which connects projects to agent pools on which the operation ran on.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "error_count",
+				Description: "The number of errors produced by this operation.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "warning_count",
+				Description: "The number of warnings produced by this operation.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "attempt",
+				Description: "Attempt number of the record.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "percent_complete",
+				Description: "The current completion percentage.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "current_operation",
+				Description: "A string that indicates the current operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "change_id",
+				Description: "The change ID.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The time the record was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModified.Time"),
+			},
+			{
+				Name:        "task_id",
+				Description: "The ID of the task, for task records.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Task.Id"),
+			},
+			{
+				Name:        "task_name",
+				Description: "The name of the task, for task records.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Task.Name"),
+			},
+			{
+				Name:        "task_version",
+				Description: "The version of the task, for task records.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Task.Version"),
+			},
+			{
+				Name:        "log_id",
+				Description: "The ID of the log produced by the record.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Log.Id"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the record.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issues",
+				Description: "The errors and warnings produced by the record.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "previous_attempts",
+				Description: "The previous attempts of the record.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "details",
+				Description: "A reference to a sub-timeline.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "log",
+				Description: "A reference to the log produced by the record.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "links",
+				Description: "The class to represent a collection of REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type BuildTimelineRecord struct {
+	build.TimelineRecord
+	BuildId         int
+	ProjectId       string
+	DurationSeconds *float64
+}
+
+func listBuildTimelineRecords(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	buildId := int(d.EqualsQuals["build_id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_timeline_record.listBuildTimelineRecords", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_timeline_record.listBuildTimelineRecords", "client_error", err)
+		return nil, err
+	}
+
+	input := build.GetBuildTimelineArgs{
+		Project: types.String(projectId),
+		BuildId: types.Int(buildId),
+	}
+
+	timeline, err := client.GetBuildTimeline(ctx, input)
+	if err != nil {
+		// Builds that never started have no timeline
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_build_timeline_record.listBuildTimelineRecords", "api_error", err)
+		return nil, err
+	}
+	if timeline == nil || timeline.Records == nil {
+		return nil, nil
+	}
+
+	for _, record := range *timeline.Records {
+		var duration *float64
+		if record.StartTime != nil && record.FinishTime != nil {
+			seconds := record.FinishTime.Time.Sub(record.StartTime.Time).Seconds()
+			duration = &seconds
+		}
+		d.StreamListItem(ctx, BuildTimelineRecord{record, buildId, projectId, duration})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,114 @@
+package azuredevops
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsBuildWorkItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_work_item",
+		Description: "Retrieve the work items associated with your builds.",
+		List: &plugin.ListConfig{
+			Hydrate: listBuildWorkItems,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "build_id", Require: plugin.Required},
+				{Name: "project_id", Require: plugin.Required},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the work item.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the work item.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type BuildWorkItem struct {
+	Id        *int
+	Url       *string
+	BuildId   int
+	ProjectId string
+}
+
+func listBuildWorkItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	buildId := int(d.EqualsQuals["build_id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_work_item.listBuildWorkItems", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_work_item.listBuildWorkItems", "client_error", err)
+		return nil, err
+	}
+
+	// The API returns 50 work items unless a larger top is requested
+	input := build.GetBuildWorkItemsRefsArgs{
+		Project: types.String(projectId),
+		BuildId: types.Int(buildId),
+		Top:     types.Int(1000),
+	}
+
+	workItems, err := client.GetBuildWorkItemsRefs(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_build_work_item.listBuildWorkItems", "api_error", err)
+		return nil, err
+	}
+
+	for _, workItem := range *workItems {
+		item := BuildWorkItem{Url: workItem.Url, BuildId: buildId, ProjectId: projectId}
+		if workItem.Id != nil {
+			if id, err := strconv.Atoi(*workItem.Id); err == nil {
+				item.Id = &id
+			}
+		}
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,268 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The location of the check configurations resource of the Pipelines Checks API
+var checkConfigurationsLocationId = uuid.MustParse("86c8381e-5aee-4cde-8ae4-25c0c7f5eaea")
+
+func tableAzureDevOpsCheckConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_check_configuration",
+		Description: "Retrieve information about the approvals and checks configured on the protected resources of your projects.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listCheckConfigurations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "resource_id", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "project_id"}),
+			Hydrate:    getCheckConfiguration,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the check configuration.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type_id",
+				Description: "The ID of the check type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type.Id"),
+			},
+			{
+				Name:        "type_name",
+				Description: "The name of the check type, e.g. Approval, Task Check, ExclusiveLock or ExtendsCheck.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type.Name"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the check, for task checks such as branch control, business hours and invoke REST API.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Settings.displayName"),
+			},
+			{
+				Name:        "definition_ref_name",
+				Description: "The name of the task run by the check, for task checks, e.g. evaluatebranchProtection or evaluateBusinessHours.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Settings.definitionRef.name"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the protected resource. Possible values are: environment, endpoint, queue, variablegroup, securefile, repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Type"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the protected resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Id"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the protected resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Name"),
+			},
+			{
+				Name:        "timeout",
+				Description: "The timeout of the check, in minutes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "created_on",
+				Description: "The time the check was configured.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "created_by_id",
+				Description: "The ID of the identity that configured the check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.Id"),
+			},
+			{
+				Name:        "created_by_name",
+				Description: "The display name of the identity that configured the check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.DisplayName"),
+			},
+			{
+				Name:        "modified_on",
+				Description: "The time the check was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifiedOn.Time"),
+			},
+			{
+				Name:        "modified_by_id",
+				Description: "The ID of the identity that last modified the check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ModifiedBy.Id"),
+			},
+			{
+				Name:        "modified_by_name",
+				Description: "The display name of the identity that last modified the check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ModifiedBy.DisplayName"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the check configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "settings",
+				Description: "The settings of the check, e.g. the approvers and minimum number of approvers of an approval, or the inputs of a task check.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource",
+				Description: "The protected resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "links",
+				Description: "The class to represent a collection of REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type.Name"),
+			},
+		}),
+	}
+}
+
+// CheckConfiguration is a check configuration as returned by the Pipelines
+// Checks API. The SDK model drops the settings.
+type CheckConfiguration struct {
+	pipelineschecks.CheckConfiguration
+	Settings  map[string]interface{} `json:"settings,omitempty"`
+	ProjectId string                 `json:"-"`
+}
+
+func listCheckConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_check_configuration.listCheckConfigurations", "connection_error", err)
+		return nil, err
+	}
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Call the route of pipelineschecks.GetCheckConfigurationsOnResource
+	// directly to keep the settings
+	routeValues := map[string]string{
+		"project": project.Id.String(),
+	}
+	queryParams := url.Values{}
+	queryParams.Add("$expand", string(pipelineschecks.CheckConfigurationExpandParameterValues.Settings))
+	if d.EqualsQuals["resource_type"] != nil {
+		queryParams.Add("resourceType", d.EqualsQuals["resource_type"].GetStringValue())
+	}
+	if d.EqualsQuals["resource_id"] != nil {
+		queryParams.Add("resourceId", d.EqualsQuals["resource_id"].GetStringValue())
+	}
+
+	resp, err := client.Send(ctx, http.MethodGet, checkConfigurationsLocationId, "6.0-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_check_configuration.listCheckConfigurations", "api_error", err)
+		return nil, err
+	}
+
+	var checkConfigurations []CheckConfiguration
+	err = client.UnmarshalCollectionBody(resp, &checkConfigurations)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_check_configuration.listCheckConfigurations", "unmarshal_error", err)
+		return nil, err
+	}
+
+	for _, checkConfiguration := range checkConfigurations {
+		checkConfiguration.ProjectId = project.Id.String()
+		d.StreamListItem(ctx, checkConfiguration)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getCheckConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	checkConfigurationId := int(d.EqualsQuals["id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_check_configuration.getCheckConfiguration", "connection_error", err)
+		return nil, err
+	}
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Call the route of pipelineschecks.GetCheckConfiguration directly to keep the settings
+	routeValues := map[string]string{
+		"project": projectId,
+		"id":      strconv.Itoa(checkConfigurationId),
+	}
+	queryParams := url.Values{}
+	queryParams.Add("$expand", string(pipelineschecks.CheckConfigurationExpandParameterValues.Settings))
+
+	resp, err := client.Send(ctx, http.MethodGet, checkConfigurationsLocationId, "6.0-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_check_configuration.getCheckConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	checkConfiguration := CheckConfiguration{ProjectId: projectId}
+	err = client.UnmarshalBody(resp, &checkConfiguration)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_check_configuration.getCheckConfiguration", "unmarshal_error", err)
+		return nil, err
+	}
+
+	return checkConfiguration, nil
+}
@@ -0,0 +1,259 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsEnvironment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_environment",
+		Description: "Retrieve information about the environments of your projects.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listEnvironments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "project_id"}),
+			Hydrate:    getEnvironment,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the environment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_on",
+				Description: "The time the environment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "created_by_id",
+				Description: "The ID of the identity that created the environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.Id"),
+			},
+			{
+				Name:        "created_by_name",
+				Description: "The display name of the identity that created the environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.DisplayName"),
+			},
+			{
+				Name:        "last_modified_on",
+				Description: "The time the environment was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModifiedOn.Time"),
+			},
+			{
+				Name:        "last_modified_by_id",
+				Description: "The ID of the identity that last modified the environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastModifiedBy.Id"),
+			},
+			{
+				Name:        "last_modified_by_name",
+				Description: "The display name of the identity that last modified the environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastModifiedBy.DisplayName"),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity that created the environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "The identity that last modified the environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "project",
+				Description: "The project of the environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type Environment struct {
+	taskagent.EnvironmentInstance
+	ProjectId string
+}
+
+func listEnvironments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment.listEnvironments", "connection_error", err)
+		return nil, err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment.listEnvironments", "client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	input := taskagent.GetEnvironmentsArgs{
+		Project: types.String(project.Id.String()),
+		Top:     types.Int(maxLimit),
+	}
+	if d.EqualsQuals["name"] != nil {
+		input.Name = types.String(d.EqualsQuals["name"].GetStringValue())
+	}
+
+	for {
+		environments, err := client.GetEnvironments(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_environment.listEnvironments", "api_error", err)
+			return nil, err
+		}
+
+		for _, environment := range environments.Value {
+			d.StreamListItem(ctx, Environment{environment, project.Id.String()})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		input.ContinuationToken = types.String(environments.ContinuationToken)
+		if environments.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+// listProjectEnvironments returns the environments of the project, or only the
+// environment given by the environment_id qual.
+func listProjectEnvironments(ctx context.Context, d *plugin.QueryData, client taskagent.Client, projectId string) ([]taskagent.EnvironmentInstance, error) {
+	if d.EqualsQuals["environment_id"] != nil {
+		input := taskagent.GetEnvironmentByIdArgs{
+			Project:       types.String(projectId),
+			EnvironmentId: types.Int(int(d.EqualsQuals["environment_id"].GetInt64Value())),
+		}
+
+		environment, err := client.GetEnvironmentById(ctx, input)
+		if err != nil {
+			// The environment belongs to another project
+			if isNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if environment == nil {
+			return nil, nil
+		}
+		return []taskagent.EnvironmentInstance{*environment}, nil
+	}
+
+	input := taskagent.GetEnvironmentsArgs{
+		Project: types.String(projectId),
+		Top:     types.Int(1000),
+	}
+
+	var environments []taskagent.EnvironmentInstance
+	for {
+		response, err := client.GetEnvironments(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		environments = append(environments, response.Value...)
+		input.ContinuationToken = types.String(response.ContinuationToken)
+		if response.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return environments, nil
+}
+
+func getEnvironment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	environmentId := int(d.EqualsQuals["id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment.getEnvironment", "connection_error", err)
+		return nil, err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment.getEnvironment", "client_error", err)
+		return nil, err
+	}
+
+	input := taskagent.GetEnvironmentByIdArgs{
+		Project:       types.String(projectId),
+		EnvironmentId: types.Int(environmentId),
+	}
+
+	environment, err := client.GetEnvironmentById(ctx, input)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_environment.getEnvironment", "api_error", err)
+		return nil, err
+	}
+
+	return Environment{*environment, projectId}, nil
+}
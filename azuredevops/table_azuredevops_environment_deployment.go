@@ -0,0 +1,227 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsEnvironmentDeployment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_environment_deployment",
+		Description: "Retrieve the deployment history of your environments.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listEnvironmentDeployments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "environment_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the deployment execution record.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "environment_id",
+				Description: "The ID of the environment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "environment_name",
+				Description: "The name of the environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the environment resource deployed to, if any.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "pipeline_id",
+				Description: "The ID of the pipeline that deployed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Definition.Id"),
+			},
+			{
+				Name:        "pipeline_name",
+				Description: "The name of the pipeline that deployed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Definition.Name"),
+			},
+			{
+				Name:        "run_id",
+				Description: "The ID of the run that deployed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Owner.Id"),
+			},
+			{
+				Name:        "run_name",
+				Description: "The name of the run that deployed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Owner.Name"),
+			},
+			{
+				Name:        "stage_name",
+				Description: "The name of the stage that deployed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stage_attempt",
+				Description: "The attempt of the stage.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "job_name",
+				Description: "The name of the job that deployed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "job_attempt",
+				Description: "The attempt of the job.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "result",
+				Description: "The result of the deployment. Possible values are: succeeded, succeededWithIssues, failed, canceled, skipped, abandoned.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "queue_time",
+				Description: "The time the deployment was queued.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("QueueTime.Time"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The time the deployment started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime.Time"),
+			},
+			{
+				Name:        "finish_time",
+				Description: "The time the deployment finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FinishTime.Time"),
+			},
+			{
+				Name:        "plan_id",
+				Description: "The ID of the orchestration plan of the deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "plan_type",
+				Description: "The type of the orchestration plan, e.g. Build.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "request_identifier",
+				Description: "The identifier of the deployment request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "definition",
+				Description: "The pipeline that deployed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "owner",
+				Description: "The run that deployed.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Owner.Name"),
+			},
+		}),
+	}
+}
+
+type EnvironmentDeployment struct {
+	taskagent.EnvironmentDeploymentExecutionRecord
+	EnvironmentName *string
+	ProjectId       string
+}
+
+func listEnvironmentDeployments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_deployment.listEnvironmentDeployments", "connection_error", err)
+		return nil, err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_deployment.listEnvironmentDeployments", "client_error", err)
+		return nil, err
+	}
+
+	environments, err := listProjectEnvironments(ctx, d, client, project.Id.String())
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_deployment.listEnvironmentDeployments", "api_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	for _, environment := range environments {
+		input := taskagent.GetEnvironmentDeploymentExecutionRecordsArgs{
+			Project:       types.String(project.Id.String()),
+			EnvironmentId: environment.Id,
+			Top:           types.Int(maxLimit),
+		}
+
+		for {
+			records, err := client.GetEnvironmentDeploymentExecutionRecords(ctx, input)
+			if err != nil {
+				plugin.Logger(ctx).Error("azuredevops_environment_deployment.listEnvironmentDeployments", "api_error", err)
+				return nil, err
+			}
+
+			for _, record := range records.Value {
+				d.StreamListItem(ctx, EnvironmentDeployment{record, environment.Name, project.Id.String()})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+			input.ContinuationToken = types.String(records.ContinuationToken)
+			if records.ContinuationToken == "" {
+				break
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,187 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsEnvironmentResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_environment_resource",
+		Description: "Retrieve information about the Kubernetes namespaces and virtual machines of your environments.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listEnvironmentResources,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "environment_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource. Possible values are: undefined, generic, virtualMachine, kubernetes.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "environment_id",
+				Description: "The ID of the environment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "environment_name",
+				Description: "The name of the environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the Kubernetes cluster, for Kubernetes resources.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEnvironmentKubernetesResource,
+			},
+			{
+				Name:        "namespace",
+				Description: "The Kubernetes namespace, for Kubernetes resources.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEnvironmentKubernetesResource,
+			},
+			{
+				Name:        "service_endpoint_id",
+				Description: "The ID of the service connection used to access the cluster, for Kubernetes resources.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getEnvironmentKubernetesResource,
+			},
+			{
+				Name:        "tags",
+				Description: "The tags of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type EnvironmentResource struct {
+	taskagent.EnvironmentResourceReference
+	EnvironmentId   int
+	EnvironmentName *string
+	ProjectId       string
+}
+
+func listEnvironmentResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_resource.listEnvironmentResources", "connection_error", err)
+		return nil, err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_resource.listEnvironmentResources", "client_error", err)
+		return nil, err
+	}
+
+	environments, err := listProjectEnvironments(ctx, d, client, project.Id.String())
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_resource.listEnvironmentResources", "api_error", err)
+		return nil, err
+	}
+
+	for _, environment := range environments {
+		// The resources are only returned when getting a single environment
+		input := taskagent.GetEnvironmentByIdArgs{
+			Project:       types.String(project.Id.String()),
+			EnvironmentId: environment.Id,
+			Expands:       &taskagent.EnvironmentExpandsValues.ResourceReferences,
+		}
+
+		result, err := client.GetEnvironmentById(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_environment_resource.listEnvironmentResources", "api_error", err)
+			return nil, err
+		}
+		if result.Resources == nil {
+			continue
+		}
+
+		for _, resource := range *result.Resources {
+			d.StreamListItem(ctx, EnvironmentResource{resource, *environment.Id, environment.Name, project.Id.String()})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func getEnvironmentKubernetesResource(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	resource := h.Item.(EnvironmentResource)
+
+	// Only Kubernetes resources have a cluster and a namespace
+	if resource.Type == nil || *resource.Type != taskagent.EnvironmentResourceTypeValues.Kubernetes {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_resource.getEnvironmentKubernetesResource", "connection_error", err)
+		return nil, err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_resource.getEnvironmentKubernetesResource", "client_error", err)
+		return nil, err
+	}
+
+	input := taskagent.GetKubernetesResourceArgs{
+		Project:       types.String(resource.ProjectId),
+		EnvironmentId: types.Int(resource.EnvironmentId),
+		ResourceId:    resource.Id,
+	}
+
+	kubernetesResource, err := client.GetKubernetesResource(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_environment_resource.getEnvironmentKubernetesResource", "api_error", err)
+		return nil, err
+	}
+
+	return kubernetesResource, nil
+}
@@ -0,0 +1,326 @@
+package azuredevops
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsGitCommit(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_commit",
+		Description: "Retrieve information about your repository commits.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGitRepositories,
+			Hydrate:       listGitCommits,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_id", Require: plugin.Optional},
+				{Name: "branch", Require: plugin.Optional},
+				{Name: "author_name", Require: plugin.Optional},
+				{Name: "committer_date", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"commit_id", "repository_id"}),
+			Hydrate:    getGitCommit,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "commit_id",
+				Description: "ID (SHA-1) of the commit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_id",
+				Description: "The repository ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "branch",
+				Description: "The branch the commit history was walked from. Only set if the branch is provided in the where clause, otherwise the default branch is used.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment",
+				Description: "Comment or message of the commit. May be truncated, see full_comment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment_truncated",
+				Description: "Indicates if the comment is truncated from the full Git commit comment message.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "full_comment",
+				Description: "The full, untruncated comment or message of the commit.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGitCommit,
+				Transform:   transform.FromField("Comment"),
+			},
+			{
+				Name:        "author_name",
+				Description: "Name of the commit author. The author filter of the commits API is pushed down from this column, since author holds the full author object.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Author.Name"),
+			},
+			{
+				Name:        "author_email",
+				Description: "Email address of the commit author.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Author.Email"),
+			},
+			{
+				Name:        "author_date",
+				Description: "The date the commit was authored.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Author.Date.Time"),
+			},
+			{
+				Name:        "committer_name",
+				Description: "Name of the committer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Committer.Name"),
+			},
+			{
+				Name:        "committer_email",
+				Description: "Email address of the committer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Committer.Email"),
+			},
+			{
+				Name:        "committer_date",
+				Description: "The date the commit was committed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Committer.Date.Time"),
+			},
+			{
+				Name:        "push_id",
+				Description: "ID of the push associated with this commit.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Push.PushId"),
+			},
+			{
+				Name:        "push_date",
+				Description: "The date of the push associated with this commit.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Push.Date.Time"),
+			},
+			{
+				Name:        "remote_url",
+				Description: "Remote URL path to the commit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "url",
+				Description: "REST URL for this resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "change_counts",
+				Description: "Counts of the types of changes (edit, add, delete, etc.) included with the commit.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "parents",
+				Description: "An enumeration of the parent commit IDs for this commit.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "push",
+				Description: "The push associated with this commit.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "author",
+				Description: "Author of the commit.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "committer",
+				Description: "Committer of the commit.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "links",
+				Description: "A collection of related REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CommitId"),
+			},
+		}),
+	}
+}
+
+type GitCommit struct {
+	git.GitCommitRef
+	RepositoryId string
+	Branch       *string
+}
+
+func listGitCommits(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := h.Item.(git.GitRepository)
+	repository_id := d.EqualsQuals["repository_id"].GetStringValue()
+
+	// check if the provided repository_id is not matching with the parentHydrate
+	if repository_id != "" && repository_id != repo.Id.String() {
+		return nil, nil
+	}
+
+	// Empty repositories have no default branch and no history to walk
+	branch := d.EqualsQuals["branch"].GetStringValue()
+	if branch == "" && repo.DefaultBranch == nil {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_commit.listGitCommits", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_commit.listGitCommits", "client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	criteria := git.GitQueryCommitsCriteria{
+		IncludeLinks:    types.Bool(true),
+		IncludePushData: types.Bool(true),
+	}
+	if branch != "" {
+		criteria.ItemVersion = &git.GitVersionDescriptor{
+			Version:     types.String(strings.TrimPrefix(branch, "refs/heads/")),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		}
+	}
+	if d.EqualsQuals["author_name"] != nil {
+		criteria.Author = types.String(d.EqualsQuals["author_name"].GetStringValue())
+	}
+	if d.Quals["committer_date"] != nil {
+		for _, q := range d.Quals["committer_date"].Quals {
+			date := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			switch q.Operator {
+			case ">", ">=":
+				criteria.FromDate = types.String(date)
+			case "<", "<=":
+				criteria.ToDate = types.String(date)
+			case "=":
+				criteria.FromDate = types.String(date)
+				criteria.ToDate = types.String(date)
+			}
+		}
+	}
+
+	input := git.GetCommitsArgs{
+		RepositoryId:   types.String(repo.Id.String()),
+		SearchCriteria: &criteria,
+		Skip:           types.Int(0),
+		Top:            types.Int(maxLimit),
+	}
+
+	var commitBranch *string
+	if branch != "" {
+		commitBranch = types.String(branch)
+	}
+
+	for {
+		commits, err := client.GetCommits(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_git_commit.listGitCommits", "api_error", err)
+			return nil, err
+		}
+
+		for _, commit := range *commits {
+			d.StreamListItem(ctx, GitCommit{commit, repo.Id.String(), commitBranch})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if len(*commits) < *input.Top {
+			break
+		}
+		input.Skip = types.Int(*input.Skip + len(*commits))
+	}
+
+	return nil, nil
+}
+
+func getGitCommit(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var repositoryId, commitId string
+	var branch *string
+	if h.Item != nil {
+		commit := h.Item.(GitCommit)
+		repositoryId = commit.RepositoryId
+		commitId = *commit.CommitId
+		branch = commit.Branch
+	} else {
+		repositoryId = d.EqualsQuals["repository_id"].GetStringValue()
+		commitId = d.EqualsQuals["commit_id"].GetStringValue()
+	}
+
+	// Check if repositoryId or commitId is empty
+	if repositoryId == "" || commitId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_commit.getGitCommit", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_commit.getGitCommit", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetCommitArgs{
+		RepositoryId: types.String(repositoryId),
+		CommitId:     types.String(commitId),
+	}
+
+	commit, err := client.GetCommit(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_commit.getGitCommit", "api_error", err)
+		return nil, err
+	}
+
+	commitRef := git.GitCommitRef{
+		Links:            commit.Links,
+		Author:           commit.Author,
+		ChangeCounts:     commit.ChangeCounts,
+		Comment:          commit.Comment,
+		CommentTruncated: commit.CommentTruncated,
+		CommitId:         commit.CommitId,
+		Committer:        commit.Committer,
+		Parents:          commit.Parents,
+		Push:             commit.Push,
+		RemoteUrl:        commit.RemoteUrl,
+		Url:              commit.Url,
+	}
+
+	return GitCommit{commitRef, repositoryId, branch}, nil
+}
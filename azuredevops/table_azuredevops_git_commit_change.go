@@ -0,0 +1,179 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsGitCommitChange(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_commit_change",
+		Description: "Retrieve information about the files changed by a commit.",
+		List: &plugin.ListConfig{
+			Hydrate: listGitCommitChanges,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_id", Require: plugin.Required},
+				{Name: "commit_id", Require: plugin.Required},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "path",
+				Description: "Path of the changed item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Path"),
+			},
+			{
+				Name:        "repository_id",
+				Description: "The repository ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "commit_id",
+				Description: "ID (SHA-1) of the commit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "change_type",
+				Description: "The type of change that was made to the item, e.g. add, edit, delete or rename.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "original_path",
+				Description: "Original path of item if different from current path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_folder",
+				Description: "True if the changed item is a folder.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Item.IsFolder"),
+			},
+			{
+				Name:        "git_object_type",
+				Description: "Type of object (Commit, Tree, Blob, Tag, ...).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.GitObjectType"),
+			},
+			{
+				Name:        "object_id",
+				Description: "Git object ID of the item after the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.ObjectId"),
+			},
+			{
+				Name:        "original_object_id",
+				Description: "Git object ID of the item before the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.OriginalObjectId"),
+			},
+			{
+				Name:        "url",
+				Description: "URL to retrieve the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Url"),
+			},
+			{
+				Name:        "item",
+				Description: "The changed item.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Item.Path"),
+			},
+		}),
+	}
+}
+
+type GitCommitChange struct {
+	ChangeType   *git.VersionControlChangeType `json:"changeType,omitempty"`
+	Item         *git.GitItem                  `json:"item,omitempty"`
+	OriginalPath *string                       `json:"originalPath,omitempty"`
+	RepositoryId string                        `json:"-"`
+	CommitId     string                        `json:"-"`
+}
+
+func listGitCommitChanges(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	repositoryId := d.EqualsQuals["repository_id"].GetStringValue()
+	commitId := d.EqualsQuals["commit_id"].GetStringValue()
+
+	// Check if repositoryId or commitId is empty
+	if repositoryId == "" || commitId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_commit_change.listGitCommitChanges", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_commit_change.listGitCommitChanges", "client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	input := git.GetChangesArgs{
+		RepositoryId: types.String(repositoryId),
+		CommitId:     types.String(commitId),
+		Skip:         types.Int(0),
+		Top:          types.Int(maxLimit),
+	}
+
+	for {
+		response, err := client.GetChanges(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_git_commit_change.listGitCommitChanges", "api_error", err)
+			return nil, err
+		}
+		if response.Changes == nil {
+			break
+		}
+
+		for _, raw := range *response.Changes {
+			// Changes are returned as free-form JSON, re-decode them into a typed item
+			data, err := json.Marshal(raw)
+			if err != nil {
+				plugin.Logger(ctx).Error("azuredevops_git_commit_change.listGitCommitChanges", "marshal_error", err)
+				return nil, err
+			}
+			change := GitCommitChange{RepositoryId: repositoryId, CommitId: commitId}
+			if err := json.Unmarshal(data, &change); err != nil {
+				plugin.Logger(ctx).Error("azuredevops_git_commit_change.listGitCommitChanges", "unmarshal_error", err)
+				return nil, err
+			}
+			d.StreamListItem(ctx, change)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if len(*response.Changes) < *input.Top {
+			break
+		}
+		input.Skip = types.Int(*input.Skip + len(*response.Changes))
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,128 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsGitDeletedRepository(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_deleted_repository",
+		Description: "Retrieve information about the soft-deleted repositories in the recycle bin of your projects.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listGitDeletedRepositories,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The repository id.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The repository name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The project Id.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project.Id"),
+			},
+			{
+				Name:        "created_date",
+				Description: "The date the repository was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedDate.Time"),
+			},
+			{
+				Name:        "deleted_date",
+				Description: "The date the repository was deleted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DeletedDate.Time"),
+			},
+			{
+				Name:        "deleted_by_id",
+				Description: "The ID of the identity that deleted the repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DeletedBy.Id"),
+			},
+			{
+				Name:        "deleted_by_name",
+				Description: "The display name of the identity that deleted the repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DeletedBy.DisplayName"),
+			},
+			{
+				Name:        "deleted_by",
+				Description: "The identity that deleted the repository.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "project",
+				Description: "The project this repository belonged to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+func listGitDeletedRepositories(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_deleted_repository.listGitDeletedRepositories", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_deleted_repository.listGitDeletedRepositories", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetRecycleBinRepositoriesArgs{
+		Project: types.String(project.Id.String()),
+	}
+
+	repositories, err := client.GetRecycleBinRepositories(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_deleted_repository.listGitDeletedRepositories", "api_error", err)
+		return nil, err
+	}
+
+	for _, repository := range *repositories {
+		d.StreamListItem(ctx, repository)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
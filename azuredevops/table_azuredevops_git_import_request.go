@@ -0,0 +1,210 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsGitImportRequest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_import_request",
+		Description: "Retrieve information about the requests to import remote repositories into your repositories.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGitRepositories,
+			Hydrate:       listGitImportRequests,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_id", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"import_request_id", "repository_id", "project_id"}),
+			Hydrate:    getGitImportRequest,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "import_request_id",
+				Description: "The unique identifier for the import request.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "repository_id",
+				Description: "The ID of the target repository of the import.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Repository.Id"),
+			},
+			{
+				Name:        "repository_name",
+				Description: "The name of the target repository of the import.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Repository.Name"),
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project of the target repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Repository.Project.Id"),
+			},
+			{
+				Name:        "status",
+				Description: "Current status of the import. Possible values are: queued, inProgress, completed, failed, abandoned.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_url",
+				Description: "The URL of the source Git repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parameters.GitSource.Url"),
+			},
+			{
+				Name:        "overwrite",
+				Description: "True if the import is a sync request.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Parameters.GitSource.Overwrite"),
+			},
+			{
+				Name:        "tfvc_path",
+				Description: "The TFVC path imported, if the source is a TFVC repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parameters.TfvcSource.Path"),
+			},
+			{
+				Name:        "service_endpoint_id",
+				Description: "The ID of the service endpoint used to connect to the source repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Parameters.ServiceEndpointId"),
+			},
+			{
+				Name:        "delete_service_endpoint_after_import_is_done",
+				Description: "True if the service endpoint is deleted once the import is done.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Parameters.DeleteServiceEndpointAfterImportIsDone"),
+			},
+			{
+				Name:        "current_step",
+				Description: "Index into all_steps for the current step.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DetailedStatus.CurrentStep"),
+			},
+			{
+				Name:        "error_message",
+				Description: "Error message if the import failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DetailedStatus.ErrorMessage"),
+			},
+			{
+				Name:        "all_steps",
+				Description: "All valid steps for the import process.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DetailedStatus.AllSteps"),
+			},
+			{
+				Name:        "url",
+				Description: "A link back to this import request resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parameters",
+				Description: "Parameters for creating the import request.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "links",
+				Description: "The class to represent a collection of REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ImportRequestId"),
+			},
+		}),
+	}
+}
+
+func listGitImportRequests(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := h.Item.(git.GitRepository)
+	repository_id := d.EqualsQuals["repository_id"].GetStringValue()
+
+	// check if the provided repository_id is not matching with the parentHydrate
+	if repository_id != "" && repository_id != repo.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_import_request.listGitImportRequests", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_import_request.listGitImportRequests", "client_error", err)
+		return nil, err
+	}
+
+	input := git.QueryImportRequestsArgs{
+		Project:          types.String(repo.Project.Id.String()),
+		RepositoryId:     types.String(repo.Id.String()),
+		IncludeAbandoned: types.Bool(true),
+	}
+
+	importRequests, err := client.QueryImportRequests(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_import_request.listGitImportRequests", "api_error", err)
+		return nil, err
+	}
+
+	for _, importRequest := range *importRequests {
+		d.StreamListItem(ctx, importRequest)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getGitImportRequest(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	importRequestId := int(d.EqualsQuals["import_request_id"].GetInt64Value())
+	repositoryId := d.EqualsQuals["repository_id"].GetStringValue()
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if repositoryId or projectId is empty
+	if repositoryId == "" || projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_import_request.getGitImportRequest", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_import_request.getGitImportRequest", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetImportRequestArgs{
+		Project:         types.String(projectId),
+		RepositoryId:    types.String(repositoryId),
+		ImportRequestId: types.Int(importRequestId),
+	}
+
+	importRequest, err := client.GetImportRequest(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_import_request.getGitImportRequest", "api_error", err)
+		return nil, err
+	}
+
+	return importRequest, nil
+}
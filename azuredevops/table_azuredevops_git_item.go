@@ -0,0 +1,300 @@
+package azuredevops
+
+import (
+	"context"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsGitItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_item",
+		Description: "Retrieve information about the files and folders of your repositories.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGitRepositories,
+			Hydrate:       listGitItems,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_id", Require: plugin.Optional},
+				{Name: "path", Require: plugin.Optional},
+				{Name: "scope_path", Require: plugin.Optional},
+				{Name: "version", Require: plugin.Optional},
+				{Name: "version_type", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "path",
+				Description: "The path of the item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_id",
+				Description: "The repository ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_folder",
+				Description: "True if the item is a folder.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_sym_link",
+				Description: "True if the item is a symbolic link.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "git_object_type",
+				Description: "Type of object (Commit, Tree, Blob, Tag, ...).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "object_id",
+				Description: "The Git object ID of the item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "commit_id",
+				Description: "SHA1 of the commit the item was fetched at.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "size",
+				Description: "Size of the file content in bytes. Null for folders.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getGitItemBlob,
+				Transform:   transform.FromField("Size"),
+			},
+			{
+				Name:        "content",
+				Description: "The text content of the file. Null for folders, binary files and files larger than 1 MiB.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGitItemContent,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "version",
+				Description: "The version (branch, tag or commit) the items were listed at. Defaults to the default branch of the repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("version"),
+			},
+			{
+				Name:        "version_type",
+				Description: "How the version is interpreted: branch (default), tag or commit.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("version_type"),
+			},
+			{
+				Name:        "scope_path",
+				Description: "The folder the items were recursively listed under. Defaults to the repository root.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("scope_path"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "links",
+				Description: "The class to represent a collection of REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Path"),
+			},
+		}),
+	}
+}
+
+type GitItem struct {
+	git.GitItem
+	RepositoryId string
+}
+
+// getGitVersionDescriptor builds the version descriptor from the version and
+// version_type quals, or returns nil to use the default branch.
+func getGitVersionDescriptor(d *plugin.QueryData) *git.GitVersionDescriptor {
+	version := d.EqualsQuals["version"].GetStringValue()
+	if version == "" {
+		return nil
+	}
+
+	versionType := git.GitVersionTypeValues.Branch
+	if d.EqualsQuals["version_type"] != nil {
+		versionType = git.GitVersionType(d.EqualsQuals["version_type"].GetStringValue())
+	}
+	if versionType == git.GitVersionTypeValues.Branch {
+		version = strings.TrimPrefix(version, "refs/heads/")
+	}
+
+	return &git.GitVersionDescriptor{
+		Version:     types.String(version),
+		VersionType: &versionType,
+	}
+}
+
+func listGitItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := h.Item.(git.GitRepository)
+	repository_id := d.EqualsQuals["repository_id"].GetStringValue()
+
+	// check if the provided repository_id is not matching with the parentHydrate
+	if repository_id != "" && repository_id != repo.Id.String() {
+		return nil, nil
+	}
+
+	// Empty repositories have no default branch and no items
+	versionDescriptor := getGitVersionDescriptor(d)
+	if versionDescriptor == nil && repo.DefaultBranch == nil {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.listGitItems", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.listGitItems", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetItemsArgs{
+		RepositoryId:      types.String(repo.Id.String()),
+		IncludeLinks:      types.Bool(true),
+		RecursionLevel:    &git.VersionControlRecursionTypeValues.Full,
+		VersionDescriptor: versionDescriptor,
+	}
+
+	// A single path is fetched on its own rather than listing the whole tree
+	if d.EqualsQuals["path"] != nil {
+		input.ScopePath = types.String(d.EqualsQuals["path"].GetStringValue())
+		input.RecursionLevel = &git.VersionControlRecursionTypeValues.None
+	} else if d.EqualsQuals["scope_path"] != nil {
+		input.ScopePath = types.String(d.EqualsQuals["scope_path"].GetStringValue())
+	}
+
+	items, err := client.GetItems(ctx, input)
+	if err != nil {
+		// The requested path or version does not exist in this repository
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_git_item.listGitItems", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range *items {
+		d.StreamListItem(ctx, GitItem{item, repo.Id.String()})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getGitItemBlob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	item := h.Item.(GitItem)
+
+	// Folders are trees, not blobs
+	if (item.IsFolder != nil && *item.IsFolder) || item.ObjectId == nil {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.getGitItemBlob", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.getGitItemBlob", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetBlobArgs{
+		RepositoryId: types.String(item.RepositoryId),
+		Sha1:         item.ObjectId,
+	}
+
+	blob, err := client.GetBlob(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.getGitItemBlob", "api_error", err)
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+// Files larger than this have no content, to bound the memory used per row
+const gitItemContentMaxSize = 1024 * 1024
+
+func getGitItemContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	item := h.Item.(GitItem)
+
+	if (item.IsFolder != nil && *item.IsFolder) || item.Path == nil {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.getGitItemContent", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.getGitItemContent", "client_error", err)
+		return nil, err
+	}
+
+	// Pin the content to the commit the item was listed at
+	input := git.GetItemTextArgs{
+		RepositoryId: types.String(item.RepositoryId),
+		Path:         item.Path,
+	}
+	if item.CommitId != nil {
+		input.VersionDescriptor = &git.GitVersionDescriptor{
+			Version:     item.CommitId,
+			VersionType: &git.GitVersionTypeValues.Commit,
+		}
+	}
+
+	body, err := client.GetItemText(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.getGitItemContent", "api_error", err)
+		return nil, err
+	}
+	defer body.Close()
+
+	// Read one byte past the limit to detect files that are too large
+	content, err := io.ReadAll(io.LimitReader(body, gitItemContentMaxSize+1))
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_item.getGitItemContent", "read_error", err)
+		return nil, err
+	}
+	if len(content) > gitItemContentMaxSize {
+		return nil, nil
+	}
+
+	// Binary files cannot be represented as text
+	if !utf8.Valid(content) {
+		return nil, nil
+	}
+
+	return string(content), nil
+}
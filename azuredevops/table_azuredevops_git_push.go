@@ -0,0 +1,321 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsGitPush(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_push",
+		Description: "Retrieve information about the pushes to your repositories.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGitRepositories,
+			Hydrate:       listGitPushes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_id", Require: plugin.Optional},
+				{Name: "pusher_id", Require: plugin.Optional},
+				{Name: "ref_name", Require: plugin.Optional},
+				{Name: "date", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"push_id", "repository_id"}),
+			Hydrate:    getGitPush,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "push_id",
+				Description: "The push ID.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "repository_id",
+				Description: "The repository ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "date",
+				Description: "The date of the push.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Date.Time"),
+			},
+			{
+				Name:        "pusher_id",
+				Description: "ID of the identity who submitted the push.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PushedBy.Id"),
+			},
+			{
+				Name:        "pusher_display_name",
+				Description: "Display name of the identity who submitted the push.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PushedBy.DisplayName"),
+			},
+			{
+				Name:        "pusher_unique_name",
+				Description: "Unique name of the identity who submitted the push.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PushedBy.UniqueName"),
+			},
+			{
+				Name:        "ref_name",
+				Description: "The ref the pushes were filtered on. Only set if the ref name is provided in the where clause, see ref_updates for all refs updated by the push.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_force_push",
+				Description: "True if any ref update of the push replaced a commit that is not an ancestor of the new commit.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getGitPushIsForcePush,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the push.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pushed_by",
+				Description: "The identity who submitted the push.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ref_updates",
+				Description: "The refs updated by the push, with their old and new object IDs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "commits",
+				Description: "The commits included in the push.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGitPush,
+			},
+			{
+				Name:        "links",
+				Description: "The class to represent a collection of REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PushId"),
+			},
+		}),
+	}
+}
+
+// The object ID used by Git for refs that are created or deleted
+const emptyObjectId = "0000000000000000000000000000000000000000"
+
+type GitPush struct {
+	git.GitPush
+	RepositoryId string
+	RefName      *string
+}
+
+func listGitPushes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := h.Item.(git.GitRepository)
+	repository_id := d.EqualsQuals["repository_id"].GetStringValue()
+
+	// check if the provided repository_id is not matching with the parentHydrate
+	if repository_id != "" && repository_id != repo.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_push.listGitPushes", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_push.listGitPushes", "client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	criteria := git.GitPushSearchCriteria{
+		IncludeLinks:      types.Bool(true),
+		IncludeRefUpdates: types.Bool(true),
+	}
+	if d.EqualsQuals["pusher_id"] != nil {
+		pusherId, err := uuid.Parse(d.EqualsQuals["pusher_id"].GetStringValue())
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_git_push.listGitPushes", "invalid_pusher_id", err)
+			return nil, fmt.Errorf("invalid pusher_id %q: %v", d.EqualsQuals["pusher_id"].GetStringValue(), err)
+		}
+		criteria.PusherId = &pusherId
+	}
+	var refName *string
+	if d.EqualsQuals["ref_name"] != nil {
+		refName = types.String(d.EqualsQuals["ref_name"].GetStringValue())
+		criteria.RefName = refName
+	}
+	if d.Quals["date"] != nil {
+		for _, q := range d.Quals["date"].Quals {
+			date := azuredevops.Time{Time: q.Value.GetTimestampValue().AsTime()}
+			switch q.Operator {
+			case ">", ">=":
+				criteria.FromDate = &date
+			case "<", "<=":
+				criteria.ToDate = &date
+			case "=":
+				criteria.FromDate = &date
+				criteria.ToDate = &date
+			}
+		}
+	}
+
+	input := git.GetPushesArgs{
+		RepositoryId:   types.String(repo.Id.String()),
+		SearchCriteria: &criteria,
+		Skip:           types.Int(0),
+		Top:            types.Int(maxLimit),
+	}
+
+	for {
+		pushes, err := client.GetPushes(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_git_push.listGitPushes", "api_error", err)
+			return nil, err
+		}
+
+		for _, push := range *pushes {
+			d.StreamListItem(ctx, GitPush{push, repo.Id.String(), refName})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if len(*pushes) < *input.Top {
+			break
+		}
+		input.Skip = types.Int(*input.Skip + len(*pushes))
+	}
+
+	return nil, nil
+}
+
+func getGitPush(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var repositoryId string
+	var pushId int
+	var refName *string
+	if h.Item != nil {
+		push := h.Item.(GitPush)
+		repositoryId = push.RepositoryId
+		pushId = *push.PushId
+		refName = push.RefName
+	} else {
+		repositoryId = d.EqualsQuals["repository_id"].GetStringValue()
+		pushId = int(d.EqualsQuals["push_id"].GetInt64Value())
+	}
+
+	// Check if repositoryId is empty
+	if repositoryId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_push.getGitPush", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_push.getGitPush", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetPushArgs{
+		RepositoryId:      types.String(repositoryId),
+		PushId:            types.Int(pushId),
+		IncludeCommits:    types.Int(100),
+		IncludeRefUpdates: types.Bool(true),
+	}
+
+	push, err := client.GetPush(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_push.getGitPush", "api_error", err)
+		return nil, err
+	}
+
+	return GitPush{*push, repositoryId, refName}, nil
+}
+
+// A ref update is a force push when the old commit is not an ancestor of the
+// new one, i.e. the merge base of both commits is not the old commit.
+func getGitPushIsForcePush(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	push := h.Item.(GitPush)
+	if push.RefUpdates == nil {
+		return false, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_push.getGitPushIsForcePush", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_push.getGitPushIsForcePush", "client_error", err)
+		return nil, err
+	}
+
+	for _, refUpdate := range *push.RefUpdates {
+		// Ref creations and deletions are never force pushes
+		if refUpdate.OldObjectId == nil || refUpdate.NewObjectId == nil ||
+			*refUpdate.OldObjectId == emptyObjectId || *refUpdate.NewObjectId == emptyObjectId {
+			continue
+		}
+
+		input := git.GetMergeBasesArgs{
+			RepositoryNameOrId: types.String(push.RepositoryId),
+			CommitId:           refUpdate.NewObjectId,
+			OtherCommitId:      refUpdate.OldObjectId,
+		}
+
+		mergeBases, err := client.GetMergeBases(ctx, input)
+		if err != nil {
+			// The replaced commit may no longer exist, which only happens when history was rewritten
+			if isNotFoundError(err) {
+				return true, nil
+			}
+			plugin.Logger(ctx).Error("azuredevops_git_push.getGitPushIsForcePush", "api_error", err)
+			return nil, err
+		}
+
+		isAncestor := false
+		for _, mergeBase := range *mergeBases {
+			if mergeBase.CommitId != nil && *mergeBase.CommitId == *refUpdate.OldObjectId {
+				isAncestor = true
+				break
+			}
+		}
+		if !isAncestor {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,268 @@
+package azuredevops
+
+import (
+	"context"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsGitRef(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_ref",
+		Description: "Retrieve information about your repository refs (branches, tags, notes and pull request refs).",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGitRepositories,
+			Hydrate:       listGitRefs,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_id", Require: plugin.Optional},
+				{Name: "ref_type", Require: plugin.Optional},
+				{Name: "filter", Require: plugin.Optional},
+				{Name: "filter_contains", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the ref, e.g. refs/heads/main or refs/tags/v1.0.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_id",
+				Description: "The repository ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ref_type",
+				Description: "The type of the ref: branch, tag, note, pull or other.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "object_id",
+				Description: "The object ID the ref points to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "peeled_object_id",
+				Description: "For annotated tags, the object ID of the tagged commit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_locked",
+				Description: "True if the ref is locked.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "filter",
+				Description: "A filter to apply to the refs (starts with), e.g. heads/ or tags/release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+			{
+				Name:        "filter_contains",
+				Description: "A filter to apply to the refs (contains).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter_contains"),
+			},
+			{
+				Name:        "tag_message",
+				Description: "The message of the annotated tag.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGitAnnotatedTag,
+				Transform:   transform.FromField("Message"),
+			},
+			{
+				Name:        "tagger_name",
+				Description: "Name of the user who created the annotated tag.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGitAnnotatedTag,
+				Transform:   transform.FromField("TaggedBy.Name"),
+			},
+			{
+				Name:        "tagger_email",
+				Description: "Email address of the user who created the annotated tag.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGitAnnotatedTag,
+				Transform:   transform.FromField("TaggedBy.Email"),
+			},
+			{
+				Name:        "tagged_date",
+				Description: "The date the annotated tag was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getGitAnnotatedTag,
+				Transform:   transform.FromField("TaggedBy.Date.Time"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the ref.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creator",
+				Description: "The identity that created the ref.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "locked_by",
+				Description: "The identity that locked the ref.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IsLockedBy"),
+			},
+			{
+				Name:        "tagged_object",
+				Description: "The git object tagged by the annotated tag.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGitAnnotatedTag,
+				Transform:   transform.FromField("TaggedObject"),
+			},
+			{
+				Name:        "links",
+				Description: "The class to represent a collection of REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+// Ref name prefixes and the ref type they map to
+var gitRefTypePrefixes = map[string]string{
+	"branch": "refs/heads/",
+	"tag":    "refs/tags/",
+	"note":   "refs/notes/",
+	"pull":   "refs/pull/",
+}
+
+type GitRef struct {
+	git.GitRef
+	RepositoryId string
+	ProjectId    string
+	RefType      string
+}
+
+func getGitRefType(name string) string {
+	for refType, prefix := range gitRefTypePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return refType
+		}
+	}
+	return "other"
+}
+
+func listGitRefs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := h.Item.(git.GitRepository)
+	repository_id := d.EqualsQuals["repository_id"].GetStringValue()
+
+	// check if the provided repository_id is not matching with the parentHydrate
+	if repository_id != "" && repository_id != repo.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_ref.listGitRefs", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_ref.listGitRefs", "client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	input := git.GetRefsArgs{
+		RepositoryId: types.String(repo.Id.String()),
+		IncludeLinks: types.Bool(true),
+		PeelTags:     types.Bool(true),
+		Top:          types.Int(maxLimit),
+	}
+
+	// The filter is matched against the ref name without the leading refs/
+	if d.EqualsQuals["filter"] != nil {
+		input.Filter = types.String(d.EqualsQuals["filter"].GetStringValue())
+	} else if d.EqualsQuals["ref_type"] != nil {
+		prefix, ok := gitRefTypePrefixes[d.EqualsQuals["ref_type"].GetStringValue()]
+		if ok {
+			input.Filter = types.String(strings.TrimPrefix(prefix, "refs/"))
+		}
+	}
+	if d.EqualsQuals["filter_contains"] != nil {
+		input.FilterContains = types.String(d.EqualsQuals["filter_contains"].GetStringValue())
+	}
+
+	for {
+		refs, err := client.GetRefs(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_git_ref.listGitRefs", "api_error", err)
+			return nil, err
+		}
+
+		for _, ref := range refs.Value {
+			d.StreamListItem(ctx, GitRef{ref, repo.Id.String(), repo.Project.Id.String(), getGitRefType(types.SafeString(ref.Name))})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		input.ContinuationToken = types.String(refs.ContinuationToken)
+		if refs.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+func getGitAnnotatedTag(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	ref := h.Item.(GitRef)
+
+	// Only annotated tags are peeled to a different object
+	if ref.RefType != "tag" || ref.PeeledObjectId == nil || *ref.PeeledObjectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_ref.getGitAnnotatedTag", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_ref.getGitAnnotatedTag", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetAnnotatedTagArgs{
+		Project:      types.String(ref.ProjectId),
+		RepositoryId: types.String(ref.RepositoryId),
+		ObjectId:     ref.ObjectId,
+	}
+
+	tag, err := client.GetAnnotatedTag(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_ref.getGitAnnotatedTag", "api_error", err)
+		return nil, err
+	}
+
+	return tag, nil
+}
@@ -1,11 +1,14 @@
 package azuredevops
 
 import (
+	"bufio"
 	"context"
+	"strings"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
@@ -18,6 +21,7 @@ func tableAzureDevOpsGitRepository(_ context.Context) *plugin.Table {
 			Hydrate: listGitRepositories,
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "project_id", Require: plugin.Optional},
+				{Name: "include_hidden", Require: plugin.Optional},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -76,6 +80,47 @@ func tableAzureDevOpsGitRepository(_ context.Context) *plugin.Table {
 				Description: "The repository web url.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "commit_count",
+				Description: "The number of commits on the default branch, capped at 10000.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getGitRepositoryCommitCount,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "last_push_date",
+				Description: "The date of the most recent push to the repository.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getGitRepositoryLastPushDate,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "branch_count",
+				Description: "The number of branches in the repository.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getGitRepositoryBranchCount,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "open_pull_request_count",
+				Description: "The number of active pull requests targeting the repository.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getGitRepositoryOpenPullRequestCount,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "has_lfs",
+				Description: "True if the .gitattributes file of the default branch tracks files with Git LFS.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getGitRepositoryHasLfs,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "include_hidden",
+				Description: "True to include hidden repositories in the results. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("include_hidden"),
+			},
 			{
 				Name:        "links",
 				Description: "The class to represent a collection of REST reference links.",
@@ -127,6 +172,9 @@ func listGitRepositories(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	if d.EqualsQuals["project_id"] != nil {
 		input.Project = types.String(d.EqualsQuals["project_id"].GetStringValue())
 	}
+	if d.EqualsQuals["include_hidden"] != nil {
+		input.IncludeHidden = types.Bool(d.EqualsQuals["include_hidden"].GetBoolValue())
+	}
 
 	repositories, err := client.GetRepositories(ctx, input)
 	if err != nil {
@@ -177,3 +225,291 @@ func getRepository(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 
 	return repo, nil
 }
+
+// The statistics columns are memoized per repository, so that queries joining
+// the table several times, or other tables, do not repeat the API calls.
+var (
+	getGitRepositoryCommitCountMemoized          = plugin.HydrateFunc(getGitRepositoryCommitCountUncached).Memoize(memoize.WithCacheKeyFunction(getGitRepositoryCacheKey("getGitRepositoryCommitCount")))
+	getGitRepositoryLastPushDateMemoized         = plugin.HydrateFunc(getGitRepositoryLastPushDateUncached).Memoize(memoize.WithCacheKeyFunction(getGitRepositoryCacheKey("getGitRepositoryLastPushDate")))
+	getGitRepositoryBranchCountMemoized          = plugin.HydrateFunc(getGitRepositoryBranchCountUncached).Memoize(memoize.WithCacheKeyFunction(getGitRepositoryCacheKey("getGitRepositoryBranchCount")))
+	getGitRepositoryOpenPullRequestCountMemoized = plugin.HydrateFunc(getGitRepositoryOpenPullRequestCountUncached).Memoize(memoize.WithCacheKeyFunction(getGitRepositoryCacheKey("getGitRepositoryOpenPullRequestCount")))
+	getGitRepositoryHasLfsMemoized               = plugin.HydrateFunc(getGitRepositoryHasLfsUncached).Memoize(memoize.WithCacheKeyFunction(getGitRepositoryCacheKey("getGitRepositoryHasLfs")))
+)
+
+// declare wrapper hydrate functions to call the memoized functions
+// - this is required when a memoized function is used for a column definition
+func getGitRepositoryCommitCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getGitRepositoryCommitCountMemoized(ctx, d, h)
+}
+
+func getGitRepositoryLastPushDate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getGitRepositoryLastPushDateMemoized(ctx, d, h)
+}
+
+func getGitRepositoryBranchCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getGitRepositoryBranchCountMemoized(ctx, d, h)
+}
+
+func getGitRepositoryOpenPullRequestCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getGitRepositoryOpenPullRequestCountMemoized(ctx, d, h)
+}
+
+func getGitRepositoryHasLfs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getGitRepositoryHasLfsMemoized(ctx, d, h)
+}
+
+// getGitRepositoryCacheKey builds a cache key for the call, per repository.
+func getGitRepositoryCacheKey(name string) plugin.HydrateFunc {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+		repo := gitRepositoryFromItem(h.Item)
+		key := name
+		if repo.Id != nil {
+			key = name + "-" + repo.Id.String()
+		}
+		return key, nil
+	}
+}
+
+// gitRepositoryFromItem returns the repository of the row, which is a value
+// when listed and a pointer when fetched with getRepository.
+func gitRepositoryFromItem(item interface{}) git.GitRepository {
+	switch repo := item.(type) {
+	case git.GitRepository:
+		return repo
+	case *git.GitRepository:
+		return *repo
+	}
+	return git.GitRepository{}
+}
+
+// Counting walks the commit history, so stop once a large repository is detected
+const gitRepositoryCommitCountMax = 10000
+
+func getGitRepositoryCommitCountUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := gitRepositoryFromItem(h.Item)
+
+	// Empty repositories have no default branch and no commits
+	if repo.Id == nil || repo.DefaultBranch == nil {
+		return 0, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryCommitCountUncached", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryCommitCountUncached", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetCommitsArgs{
+		RepositoryId: types.String(repo.Id.String()),
+		SearchCriteria: &git.GitQueryCommitsCriteria{
+			ItemVersion: &git.GitVersionDescriptor{
+				Version:     types.String(strings.TrimPrefix(*repo.DefaultBranch, "refs/heads/")),
+				VersionType: &git.GitVersionTypeValues.Branch,
+			},
+		},
+		Skip: types.Int(0),
+		Top:  types.Int(1000),
+	}
+
+	count := 0
+	for {
+		commits, err := client.GetCommits(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryCommitCountUncached", "api_error", err)
+			return nil, err
+		}
+		count += len(*commits)
+		if len(*commits) < *input.Top || count >= gitRepositoryCommitCountMax {
+			break
+		}
+		input.Skip = types.Int(*input.Skip + *input.Top)
+	}
+	if count > gitRepositoryCommitCountMax {
+		count = gitRepositoryCommitCountMax
+	}
+
+	return count, nil
+}
+
+func getGitRepositoryLastPushDateUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := gitRepositoryFromItem(h.Item)
+	if repo.Id == nil {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryLastPushDateUncached", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryLastPushDateUncached", "client_error", err)
+		return nil, err
+	}
+
+	// Pushes are returned most recent first
+	input := git.GetPushesArgs{
+		RepositoryId: types.String(repo.Id.String()),
+		Top:          types.Int(1),
+	}
+
+	pushes, err := client.GetPushes(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryLastPushDateUncached", "api_error", err)
+		return nil, err
+	}
+	if len(*pushes) == 0 || (*pushes)[0].Date == nil {
+		return nil, nil
+	}
+
+	return (*pushes)[0].Date.Time, nil
+}
+
+func getGitRepositoryBranchCountUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := gitRepositoryFromItem(h.Item)
+	if repo.Id == nil {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryBranchCountUncached", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryBranchCountUncached", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetRefsArgs{
+		RepositoryId: types.String(repo.Id.String()),
+		Filter:       types.String("heads/"),
+		Top:          types.Int(1000),
+	}
+
+	count := 0
+	for {
+		refs, err := client.GetRefs(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryBranchCountUncached", "api_error", err)
+			return nil, err
+		}
+		count += len(refs.Value)
+		input.ContinuationToken = types.String(refs.ContinuationToken)
+		if refs.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return count, nil
+}
+
+func getGitRepositoryOpenPullRequestCountUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := gitRepositoryFromItem(h.Item)
+	if repo.Id == nil {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryOpenPullRequestCountUncached", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryOpenPullRequestCountUncached", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetPullRequestsArgs{
+		RepositoryId: types.String(repo.Id.String()),
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			Status: &git.PullRequestStatusValues.Active,
+		},
+		Skip: types.Int(0),
+		Top:  types.Int(1000),
+	}
+
+	count := 0
+	for {
+		pullRequests, err := client.GetPullRequests(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryOpenPullRequestCountUncached", "api_error", err)
+			return nil, err
+		}
+		count += len(*pullRequests)
+		if len(*pullRequests) < *input.Top {
+			break
+		}
+		input.Skip = types.Int(*input.Skip + *input.Top)
+	}
+
+	return count, nil
+}
+
+func getGitRepositoryHasLfsUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := gitRepositoryFromItem(h.Item)
+
+	// Empty repositories have no default branch and no files
+	if repo.Id == nil || repo.DefaultBranch == nil {
+		return false, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryHasLfsUncached", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryHasLfsUncached", "client_error", err)
+		return nil, err
+	}
+
+	// Files are stored in LFS when a filter=lfs attribute matches them
+	input := git.GetItemTextArgs{
+		RepositoryId: types.String(repo.Id.String()),
+		Path:         types.String("/.gitattributes"),
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     types.String(strings.TrimPrefix(*repo.DefaultBranch, "refs/heads/")),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	}
+
+	body, err := client.GetItemText(ctx, input)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryHasLfsUncached", "api_error", err)
+		return nil, err
+	}
+	defer body.Close()
+
+	// Each line is a pattern followed by attributes, lines starting with # are comments
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		for _, attribute := range fields[1:] {
+			if attribute == "filter=lfs" {
+				return true, nil
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository.getGitRepositoryHasLfsUncached", "read_error", err)
+		return nil, err
+	}
+
+	return false, nil
+}
@@ -2,6 +2,7 @@ package azuredevops
 
 import (
 	"context"
+	"strings"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
 	"github.com/turbot/go-kit/types"
@@ -19,11 +20,16 @@ func tableAzureDevOpsGitRepositoryBranch(_ context.Context) *plugin.Table {
 			Hydrate:       listGitRepositoryBranches,
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "repository_id", Require: plugin.Optional},
+				{Name: "base_version", Require: plugin.Optional},
 			},
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"name", "repository_id"}),
-			Hydrate:    getRepositoryBranch,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Required},
+				{Name: "repository_id", Require: plugin.Required},
+				{Name: "base_version", Require: plugin.Optional},
+			},
+			Hydrate: getRepositoryBranch,
 		},
 		Columns: commonColumns([]*plugin.Column{
 			{
@@ -36,6 +42,11 @@ func tableAzureDevOpsGitRepositoryBranch(_ context.Context) *plugin.Table {
 				Description: "The repository ID.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "base_version",
+				Description: "The branch the ahead and behind counts are relative to. Defaults to the default branch of the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "ahead_count",
 				Description: "Number of commits ahead.",
@@ -51,6 +62,30 @@ func tableAzureDevOpsGitRepositoryBranch(_ context.Context) *plugin.Table {
 				Description: "True if this is the result for the base version.",
 				Type:        proto.ColumnType_BOOL,
 			},
+			{
+				Name:        "last_commit_id",
+				Description: "ID (SHA-1) of the current commit of the branch.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Commit.CommitId"),
+			},
+			{
+				Name:        "last_commit_date",
+				Description: "The date the current commit of the branch was committed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Commit.Committer.Date.Time"),
+			},
+			{
+				Name:        "last_committer",
+				Description: "Name of the committer of the current commit of the branch.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Commit.Committer.Name"),
+			},
+			{
+				Name:        "last_committer_email",
+				Description: "Email address of the committer of the current commit of the branch.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Commit.Committer.Email"),
+			},
 			{
 				Name:        "commit",
 				Description: "Current commit.",
@@ -71,6 +106,21 @@ func tableAzureDevOpsGitRepositoryBranch(_ context.Context) *plugin.Table {
 type Branch struct {
 	git.GitBranchStats
 	RepositoryId string
+	BaseVersion  *string
+}
+
+// getBranchBaseVersionDescriptor returns the version descriptor for the base_version qual,
+// or nil to compare against the default branch.
+func getBranchBaseVersionDescriptor(d *plugin.QueryData) *git.GitVersionDescriptor {
+	baseVersion := d.EqualsQuals["base_version"].GetStringValue()
+	if baseVersion == "" {
+		return nil
+	}
+
+	return &git.GitVersionDescriptor{
+		Version:     types.String(strings.TrimPrefix(baseVersion, "refs/heads/")),
+		VersionType: &git.GitVersionTypeValues.Branch,
+	}
 }
 
 func listGitRepositoryBranches(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -94,7 +144,12 @@ func listGitRepositoryBranches(ctx context.Context, d *plugin.QueryData, h *plug
 	}
 
 	input := git.GetBranchesArgs{
-		RepositoryId: types.String(repo.Id.String()),
+		RepositoryId:          types.String(repo.Id.String()),
+		BaseVersionDescriptor: getBranchBaseVersionDescriptor(d),
+	}
+	var baseVersion *string
+	if d.EqualsQuals["base_version"] != nil {
+		baseVersion = types.String(d.EqualsQuals["base_version"].GetStringValue())
 	}
 
 	branches, err := client.GetBranches(ctx, input)
@@ -104,7 +159,7 @@ func listGitRepositoryBranches(ctx context.Context, d *plugin.QueryData, h *plug
 	}
 
 	for _, branch := range *branches {
-		d.StreamListItem(ctx, Branch{branch, repo.Id.String()})
+		d.StreamListItem(ctx, Branch{branch, repo.Id.String(), baseVersion})
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
 		if d.RowsRemaining(ctx) == 0 {
@@ -136,8 +191,13 @@ func getRepositoryBranch(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	}
 
 	input := git.GetBranchArgs{
-		RepositoryId: types.String(repositoryId),
-		Name:         types.String(branchName),
+		RepositoryId:          types.String(repositoryId),
+		Name:                  types.String(branchName),
+		BaseVersionDescriptor: getBranchBaseVersionDescriptor(d),
+	}
+	var baseVersion *string
+	if d.EqualsQuals["base_version"] != nil {
+		baseVersion = types.String(d.EqualsQuals["base_version"].GetStringValue())
 	}
 
 	branch, err := client.GetBranch(ctx, input)
@@ -146,5 +206,5 @@ func getRepositoryBranch(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		return nil, err
 	}
 
-	return Branch{*branch, repositoryId}, nil
+	return Branch{*branch, repositoryId, baseVersion}, nil
 }
@@ -0,0 +1,153 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsGitRepositoryFork(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_repository_fork",
+		Description: "Retrieve information about the forks of your repositories.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGitRepositories,
+			Hydrate:       listGitRepositoryForks,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the fork.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the fork.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_id",
+				Description: "The ID of the repository the fork was created from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_name",
+				Description: "The name of the repository the fork was created from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project the fork belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project.Id"),
+			},
+			{
+				Name:        "project_name",
+				Description: "The name of the project the fork belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project.Name"),
+			},
+			{
+				Name:        "is_fork",
+				Description: "True if the repository was created as a fork.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "remote_url",
+				Description: "The fork remote url.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ssh_url",
+				Description: "The fork ssh url.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "url",
+				Description: "The fork url.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "collection",
+				Description: "The project collection where the fork resides.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "project",
+				Description: "The project the fork belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type GitRepositoryFork struct {
+	git.GitRepositoryRef
+	RepositoryId   string
+	RepositoryName *string
+}
+
+func listGitRepositoryForks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo := h.Item.(git.GitRepository)
+	repository_id := d.EqualsQuals["repository_id"].GetStringValue()
+
+	// check if the provided repository_id is not matching with the parentHydrate
+	if repository_id != "" && repository_id != repo.Id.String() {
+		return nil, nil
+	}
+
+	collectionId, err := getCollectionId(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository_fork.listGitRepositoryForks", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository_fork.listGitRepositoryForks", "client_error", err)
+		return nil, err
+	}
+
+	id := collectionId.(uuid.UUID)
+	input := git.GetForksArgs{
+		RepositoryNameOrId: types.String(repo.Id.String()),
+		CollectionId:       &id,
+	}
+
+	forks, err := client.GetForks(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_git_repository_fork.listGitRepositoryForks", "api_error", err)
+		return nil, err
+	}
+
+	for _, fork := range *forks {
+		d.StreamListItem(ctx, GitRepositoryFork{fork, repo.Id.String(), repo.Name})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -2,7 +2,14 @@ package azuredevops
 
 import (
 	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelines"
 	"github.com/turbot/go-kit/types"
@@ -11,6 +18,9 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+// The location of the pipelines resource of the Pipelines API
+var pipelinesLocationId = uuid.MustParse("28e1305e-2afe-47bf-abaf-cbb0e6a91988")
+
 func tableAzureDevOpsPipeline(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "azuredevops_pipeline",
@@ -20,6 +30,8 @@ func tableAzureDevOpsPipeline(_ context.Context) *plugin.Table {
 			Hydrate:       listPipelines,
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "project_id", Require: plugin.Optional},
+				{Name: "folder", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -44,10 +56,32 @@ func tableAzureDevOpsPipeline(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "configuration_type",
-				Description: "Type of the pipeline configuration.",
+				Description: "Type of the pipeline configuration. Possible values are: unknown, yaml, designerJson, justInTime, designerHyphenJson.",
 				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipeline,
 				Transform:   transform.FromField("Configuration.Type"),
 			},
+			{
+				Name:        "configuration_path",
+				Description: "The path of the YAML file of the pipeline in its repository.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipeline,
+				Transform:   transform.FromField("Configuration.Path"),
+			},
+			{
+				Name:        "configuration_repository_id",
+				Description: "The ID of the repository of the YAML file of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipeline,
+				Transform:   transform.FromField("Configuration.Repository.Id"),
+			},
+			{
+				Name:        "configuration_repository_type",
+				Description: "The type of the repository of the YAML file of the pipeline. Possible values are: unknown, gitHub, azureReposGit, azureReposGitHyphenated.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipeline,
+				Transform:   transform.FromField("Configuration.Repository.Type"),
+			},
 			{
 				Name:        "folder",
 				Description: "Pipeline folder.",
@@ -63,6 +97,12 @@ func tableAzureDevOpsPipeline(_ context.Context) *plugin.Table {
 				Description: "URL of the pipeline.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "configuration",
+				Description: "The configuration of the pipeline.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipeline,
+			},
 			{
 				Name:        "links",
 				Description: "The class to represent a collection of REST reference links.",
@@ -80,9 +120,23 @@ func tableAzureDevOpsPipeline(_ context.Context) *plugin.Table {
 	}
 }
 
+// Pipeline is a pipeline as returned by the Pipelines API. The SDK model only
+// keeps the type of the configuration.
 type Pipeline struct {
 	pipelines.Pipeline
-	ProjectId string
+	Configuration *PipelineConfiguration `json:"configuration,omitempty"`
+	ProjectId     string                 `json:"-"`
+}
+
+type PipelineConfiguration struct {
+	Type       *pipelines.ConfigurationType     `json:"type,omitempty"`
+	Path       *string                          `json:"path,omitempty"`
+	Repository *PipelineConfigurationRepository `json:"repository,omitempty"`
+}
+
+type PipelineConfigurationRepository struct {
+	Id   *string `json:"id,omitempty"`
+	Type *string `json:"type,omitempty"`
 }
 
 func listPipelines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -99,30 +153,43 @@ func listPipelines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		plugin.Logger(ctx).Error("azuredevops_pipeline.listPipelines", "connection_error", err)
 		return nil, err
 	}
-	client := pipelines.NewClient(ctx, connection)
 
 	// Limiting the results
-	maxLimit := 1000
+	maxLimit := 0
 	if d.QueryContext.Limit != nil {
-		limit := int(*d.QueryContext.Limit)
-		if limit < maxLimit {
-			maxLimit = limit
-		}
+		maxLimit = int(*d.QueryContext.Limit)
 	}
 
-	input := pipelines.ListPipelinesArgs{
-		Project: types.String(project.Id.String()),
-		Top:     types.Int(maxLimit),
+	// The Pipelines API has no folder or name filter and only returns the
+	// configuration of a single pipeline, but the build definitions API has
+	// both, and every pipeline is a build definition with the same ID
+	filtered := d.EqualsQuals["folder"] != nil || d.EqualsQuals["name"] != nil
+	var configurations map[int]*PipelineConfiguration
+	if filtered || pipelineConfigurationRequested(d) {
+		configurations, err = listProjectPipelineConfigurations(ctx, d, connection, project.Id.String())
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_pipeline.listPipelines", "api_error", err)
+			return nil, err
+		}
+		// The limit applies to the pipelines matching the quals
+		if filtered {
+			maxLimit = 0
+		}
 	}
 
-	pipelines, err := client.ListPipelines(ctx, input)
+	projectPipelines, err := listProjectPipelines(ctx, connection, project.Id.String(), maxLimit)
 	if err != nil {
 		plugin.Logger(ctx).Error("azuredevops_pipeline.listPipelines", "api_error", err)
 		return nil, err
 	}
 
-	for _, pipeline := range *pipelines {
-		d.StreamListItem(ctx, Pipeline{pipeline, project.Id.String()})
+	for _, pipeline := range projectPipelines {
+		configuration, ok := configurations[*pipeline.Id]
+		if filtered && !ok {
+			continue
+		}
+		pipeline.Configuration = configuration
+		d.StreamListItem(ctx, pipeline)
 
 		// Context can be cancelled due to manual cancellation or the limit has been hit
 		if d.RowsRemaining(ctx) == 0 {
@@ -133,9 +200,160 @@ func listPipelines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	return nil, nil
 }
 
+// pipelineConfigurationRequested returns true if any configuration column is selected.
+func pipelineConfigurationRequested(d *plugin.QueryData) bool {
+	for _, column := range d.QueryContext.Columns {
+		if strings.HasPrefix(column, "configuration") {
+			return true
+		}
+	}
+	return false
+}
+
+// listProjectPipelineConfigurations returns the configurations of the build
+// definitions of the project matching the folder and name quals, by ID. The
+// configuration is nil when it can not be built from the definition, and is
+// then fetched by getPipeline.
+func listProjectPipelineConfigurations(ctx context.Context, d *plugin.QueryData, connection *azuredevops.Connection, projectId string) (map[int]*PipelineConfiguration, error) {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Call the route of build.GetDefinitions directly, since its response type
+	// drops the repository and process returned with includeAllProperties
+	routeValues := map[string]string{
+		"project": projectId,
+	}
+	queryParams := url.Values{}
+	queryParams.Add("$top", "1000")
+	queryParams.Add("includeAllProperties", "true")
+	if d.EqualsQuals["folder"] != nil {
+		queryParams.Add("path", d.EqualsQuals["folder"].GetStringValue())
+	}
+	if d.EqualsQuals["name"] != nil {
+		queryParams.Add("name", d.EqualsQuals["name"].GetStringValue())
+	}
+
+	configurations := map[int]*PipelineConfiguration{}
+	for {
+		resp, err := client.Send(ctx, http.MethodGet, buildDefinitionsLocationId, "6.0", routeValues, queryParams, nil, "", "application/json", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var definitions []build.BuildDefinition
+		err = client.UnmarshalCollectionBody(resp, &definitions)
+		if err != nil {
+			return nil, err
+		}
+		for _, definition := range definitions {
+			if definition.Id != nil {
+				configurations[*definition.Id] = pipelineConfigurationFromDefinition(definition)
+			}
+		}
+
+		continuationToken := resp.Header.Get(azuredevops.HeaderKeyContinuationToken)
+		if continuationToken == "" {
+			break
+		}
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	return configurations, nil
+}
+
+// The repository types of the Pipelines API, by repository type of the build definitions API
+var pipelineRepositoryTypes = map[string]string{
+	"TfsGit": "azureReposGit",
+	"GitHub": "gitHub",
+}
+
+// pipelineConfigurationFromDefinition returns the configuration of a YAML
+// pipeline stored in a known repository type, as returned by the Pipelines API.
+func pipelineConfigurationFromDefinition(definition build.BuildDefinition) *PipelineConfiguration {
+	process, ok := definition.Process.(map[string]interface{})
+	if !ok || process["type"] != float64(yamlProcessType) || definition.Repository == nil {
+		return nil
+	}
+	yamlFilename, ok := process["yamlFilename"].(string)
+	if !ok {
+		return nil
+	}
+	repositoryType, ok := pipelineRepositoryTypes[types.SafeString(definition.Repository.Type)]
+	if !ok {
+		return nil
+	}
+
+	return &PipelineConfiguration{
+		Type: &pipelines.ConfigurationTypeValues.Yaml,
+		Path: types.String(yamlFilename),
+		Repository: &PipelineConfigurationRepository{
+			Id:   definition.Repository.Id,
+			Type: types.String(repositoryType),
+		},
+	}
+}
+
+// listProjectPipelines returns the pipelines of the project ordered by name, at
+// most limit of them if limit is positive.
+func listProjectPipelines(ctx context.Context, connection *azuredevops.Connection, projectId string, limit int) ([]Pipeline, error) {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	pageSize := 1000
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+
+	// Call the route of pipelines.ListPipelines directly, since it drops the
+	// continuation token returned in the response headers
+	routeValues := map[string]string{
+		"project": projectId,
+	}
+	queryParams := url.Values{}
+	queryParams.Add("$top", strconv.Itoa(pageSize))
+	queryParams.Add("orderBy", "name asc")
+
+	var projectPipelines []Pipeline
+	for {
+		resp, err := client.Send(ctx, http.MethodGet, pipelinesLocationId, "6.0-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var pipelineList []Pipeline
+		err = client.UnmarshalCollectionBody(resp, &pipelineList)
+		if err != nil {
+			return nil, err
+		}
+		for _, pipeline := range pipelineList {
+			pipeline.ProjectId = projectId
+			projectPipelines = append(projectPipelines, pipeline)
+		}
+
+		continuationToken := resp.Header.Get(azuredevops.HeaderKeyContinuationToken)
+		if continuationToken == "" || (limit > 0 && len(projectPipelines) >= limit) {
+			break
+		}
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	return projectPipelines, nil
+}
+
 func getPipeline(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	pipelineId := d.EqualsQuals["id"].GetInt64Value()
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	var projectId string
+	var pipelineId int
+	if h.Item != nil {
+		pipeline := h.Item.(Pipeline)
+
+		// The configuration of pipelines listed with their build definitions is already known
+		if pipeline.Configuration != nil {
+			return pipeline, nil
+		}
+		projectId = pipeline.ProjectId
+		pipelineId = *pipeline.Id
+	} else {
+		projectId = d.EqualsQuals["project_id"].GetStringValue()
+		pipelineId = int(d.EqualsQuals["id"].GetInt64Value())
+	}
 
 	// Check if projectId is empty
 	if projectId == "" {
@@ -147,18 +365,39 @@ func getPipeline(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 		plugin.Logger(ctx).Error("azuredevops_pipeline.getPipeline", "connection_error", err)
 		return nil, err
 	}
-	client := pipelines.NewClient(ctx, connection)
+	pipeline, err := getProjectPipeline(ctx, connection, projectId, pipelineId)
+	if err != nil {
+		// The pipeline can be deleted while listing
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_pipeline.getPipeline", "api_error", err)
+		return nil, err
+	}
+
+	return *pipeline, nil
+}
+
+// getProjectPipeline returns the pipeline of the project with its configuration.
+func getProjectPipeline(ctx context.Context, connection *azuredevops.Connection, projectId string, pipelineId int) (*Pipeline, error) {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Call the route of pipelines.GetPipeline directly to keep the configuration
+	routeValues := map[string]string{
+		"project":    projectId,
+		"pipelineId": strconv.Itoa(pipelineId),
+	}
 
-	input := pipelines.GetPipelineArgs{
-		Project:    types.String(projectId),
-		PipelineId: types.Int(int(pipelineId)),
+	resp, err := client.Send(ctx, http.MethodGet, pipelinesLocationId, "6.0-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
 	}
 
-	pipeline, err := client.GetPipeline(ctx, input)
+	pipeline := Pipeline{ProjectId: projectId}
+	err = client.UnmarshalBody(resp, &pipeline)
 	if err != nil {
-		plugin.Logger(ctx).Error("azuredevops_pipeline.getPipeline", "api_error", err)
 		return nil, err
 	}
 
-	return Pipeline{*pipeline, projectId}, nil
+	return &pipeline, nil
 }
@@ -0,0 +1,298 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinesapproval"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The version of the Approvals API, which has no client in the SDK
+const pipelineApprovalsApiVersion = "7.1-preview.1"
+
+func tableAzureDevOpsPipelineApproval(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_approval",
+		Description: "Retrieve information about the approvals gating the runs of your pipelines.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listPipelineApprovals,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "project_id"}),
+			Hydrate:    getPipelineApproval,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the approval.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the approval. Possible values are: undefined, uninitiated, pending, approved, rejected, skipped, canceled, timedOut, failed, completed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_id",
+				Description: "The ID of the pipeline of the run the approval gates.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(pipelineApprovalPipelineId),
+			},
+			{
+				Name:        "pipeline_name",
+				Description: "The name of the pipeline of the run the approval gates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Pipeline.Name"),
+			},
+			{
+				Name:        "run_id",
+				Description: "The ID of the run the approval gates.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Pipeline.Owner.Id"),
+			},
+			{
+				Name:        "run_name",
+				Description: "The name of the run the approval gates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Pipeline.Owner.Name"),
+			},
+			{
+				Name:        "instructions",
+				Description: "The instructions for the approvers.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "min_required_approvers",
+				Description: "The minimum number of approvers that should approve for the entire approval to be considered approved.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "execution_order",
+				Description: "The order in which approvers are actionable. Possible values are: anyOrder, inSequence.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_on",
+				Description: "The time the approval was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "last_modified_on",
+				Description: "The time the approval was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModifiedOn.Time"),
+			},
+			{
+				Name:        "approvers",
+				Description: "The display names of the approvers assigned to the steps of the approval.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(pipelineApprovalApprovers),
+			},
+			{
+				Name:        "steps",
+				Description: "The steps of the approval, with the assigned and actual approver, status and comment of each.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "blocked_approvers",
+				Description: "The identities not allowed to approve.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pipeline",
+				Description: "The pipeline and run the approval gates.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "links",
+				Description: "The class to represent a collection of REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+// PipelineApproval is an approval as returned by the Approvals API. The SDK
+// model drops the pipeline and run the approval gates.
+type PipelineApproval struct {
+	pipelinesapproval.Approval
+	Pipeline  *PipelineApprovalPipeline `json:"pipeline,omitempty"`
+	ProjectId string                    `json:"-"`
+}
+
+type PipelineApprovalPipeline struct {
+	// The ID is returned as a string
+	Id    json.Number               `json:"id,omitempty"`
+	Name  *string                   `json:"name,omitempty"`
+	Owner *PipelineApprovalRunOwner `json:"owner,omitempty"`
+}
+
+type PipelineApprovalRunOwner struct {
+	Id   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+func listPipelineApprovals(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_approval.listPipelineApprovals", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("top", strconv.Itoa(maxLimit))
+	// The API only returns pending approvals when no state is given
+	if d.EqualsQuals["status"] != nil {
+		queryParams.Add("state", d.EqualsQuals["status"].GetStringValue())
+	} else {
+		queryParams.Add("state", string(pipelinesapproval.ApprovalStatusValues.All))
+	}
+
+	for {
+		approvals, continuationToken, err := queryPipelineApprovals(ctx, connection, project.Id.String(), queryParams)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_pipeline_approval.listPipelineApprovals", "api_error", err)
+			return nil, err
+		}
+
+		for _, approval := range approvals {
+			d.StreamListItem(ctx, approval)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if continuationToken == "" {
+			break
+		}
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	return nil, nil
+}
+
+func getPipelineApproval(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	approvalId := d.EqualsQuals["id"].GetStringValue()
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if approvalId or projectId is empty
+	if approvalId == "" || projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_approval.getPipelineApproval", "connection_error", err)
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("approvalIds", approvalId)
+
+	approvals, _, err := queryPipelineApprovals(ctx, connection, projectId, queryParams)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_approval.getPipelineApproval", "api_error", err)
+		return nil, err
+	}
+	if len(approvals) == 0 {
+		return nil, nil
+	}
+
+	return approvals[0], nil
+}
+
+// queryPipelineApprovals calls the Approvals API of the project, expanding the
+// steps of each approval. It returns a page of approvals and the continuation
+// token of the next page.
+func queryPipelineApprovals(ctx context.Context, connection *azuredevops.Connection, projectId string, queryParams url.Values) ([]PipelineApproval, string, error) {
+	queryParams.Set("$expand", string(pipelinesapproval.ApprovalDetailsExpandParameterValues.Steps))
+
+	resp, err := sendProjectRequest(ctx, connection, projectId, "_apis/pipelines/approvals", pipelineApprovalsApiVersion, queryParams)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var approvals []PipelineApproval
+	err = connection.GetClientByUrl(connection.BaseUrl).UnmarshalCollectionBody(resp, &approvals)
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range approvals {
+		approvals[i].ProjectId = projectId
+	}
+
+	return approvals, resp.Header.Get(azuredevops.HeaderKeyContinuationToken), nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func pipelineApprovalPipelineId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	approval := d.HydrateItem.(PipelineApproval)
+	if approval.Pipeline == nil || approval.Pipeline.Id == "" {
+		return nil, nil
+	}
+	id, err := approval.Pipeline.Id.Int64()
+	if err != nil {
+		return nil, nil
+	}
+	return id, nil
+}
+
+func pipelineApprovalApprovers(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	approval := d.HydrateItem.(PipelineApproval)
+	if approval.Steps == nil {
+		return nil, nil
+	}
+	var approvers []string
+	for _, step := range *approval.Steps {
+		if step.AssignedApprover != nil && step.AssignedApprover.DisplayName != nil {
+			approvers = append(approvers, *step.AssignedApprover.DisplayName)
+		}
+	}
+	return approvers, nil
+}
@@ -0,0 +1,181 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelines"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsPipelinePreview(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_preview",
+		Description: "Retrieve the final YAML of your pipelines, with templates expanded, without queueing a run.",
+		List: &plugin.ListConfig{
+			Hydrate: listPipelinePreviews,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "pipeline_id", Require: plugin.Required},
+				{Name: "branch", Require: plugin.Optional},
+				{Name: "template_parameters", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "pipeline_id",
+				Description: "The ID of the pipeline.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "branch",
+				Description: "The branch of the pipeline repository the YAML is read from. Defaults to the default branch of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("branch"),
+			},
+			{
+				Name:        "template_parameters",
+				Description: "The template parameters the YAML is expanded with, e.g. {\"environment\": \"prod\"}.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("template_parameters"),
+			},
+			{
+				Name:        "final_yaml",
+				Description: "The final YAML of the pipeline, with templates expanded.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "error_message",
+				Description: "The reason the YAML could not be expanded, e.g. a template or parameter error.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PipelineId"),
+			},
+		}),
+	}
+}
+
+type PipelinePreview struct {
+	PipelineId   int
+	ProjectId    string
+	FinalYaml    *string
+	ErrorMessage *string
+}
+
+func listPipelinePreviews(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	pipelineId := int(d.EqualsQuals["pipeline_id"].GetInt64Value())
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	parameters := pipelines.RunPipelineParameters{
+		PreviewRun: types.Bool(true),
+	}
+	if d.EqualsQuals["branch"] != nil {
+		branch := d.EqualsQuals["branch"].GetStringValue()
+		if !strings.HasPrefix(branch, "refs/") {
+			branch = "refs/heads/" + branch
+		}
+		parameters.Resources = &pipelines.RunResourcesParameters{
+			Repositories: &map[string]pipelines.RepositoryResourceParameters{
+				"self": {RefName: types.String(branch)},
+			},
+		}
+	}
+	if d.EqualsQuals["template_parameters"] != nil {
+		templateParameters, err := getPipelinePreviewTemplateParameters(d.EqualsQuals["template_parameters"].GetJsonbValue())
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_pipeline_preview.listPipelinePreviews", "qual_error", err)
+			return nil, err
+		}
+		parameters.TemplateParameters = &templateParameters
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_preview.listPipelinePreviews", "connection_error", err)
+		return nil, err
+	}
+	client := pipelines.NewClient(ctx, connection)
+
+	input := pipelines.RunPipelineArgs{
+		Project:       types.String(projectId),
+		PipelineId:    types.Int(pipelineId),
+		RunParameters: &parameters,
+	}
+
+	preview := PipelinePreview{PipelineId: pipelineId, ProjectId: projectId}
+	run, err := client.RunPipeline(ctx, input)
+	if err != nil {
+		// Invalid YAML, templates or parameters are reported as a bad request,
+		// which is returned as a row so that many pipelines can be validated at once
+		message, ok := getBadRequestMessage(err)
+		if !ok {
+			plugin.Logger(ctx).Error("azuredevops_pipeline_preview.listPipelinePreviews", "api_error", err)
+			return nil, err
+		}
+		preview.ErrorMessage = types.String(message)
+	} else {
+		preview.FinalYaml = run.FinalYaml
+	}
+
+	d.StreamListItem(ctx, preview)
+
+	return nil, nil
+}
+
+// getPipelinePreviewTemplateParameters converts a JSON object into the string
+// values expected by the API. Non-string values, e.g. objects for object
+// parameters, are passed as JSON, which is valid YAML.
+func getPipelinePreviewTemplateParameters(value string) (map[string]string, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, err
+	}
+
+	parameters := map[string]string{}
+	for name, v := range raw {
+		if s, ok := v.(string); ok {
+			parameters[name] = s
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		parameters[name] = string(b)
+	}
+
+	return parameters, nil
+}
+
+// getBadRequestMessage returns the message of a bad request error.
+func getBadRequestMessage(err error) (string, bool) {
+	statusCode, wrappedError := getWrappedErrorStatus(err)
+	if statusCode != http.StatusBadRequest {
+		return "", false
+	}
+	if wrappedError.Message == nil {
+		return err.Error(), true
+	}
+	return *wrappedError.Message, true
+}
@@ -0,0 +1,314 @@
+package azuredevops
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinepermissions"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The types of the resources pipelines must be authorized to use
+var pipelineProtectedResourceTypes = []string{"endpoint", "variablegroup", "securefile", "queue", "environment", "repository"}
+
+func tableAzureDevOpsPipelineResourcePermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_resource_permission",
+		Description: "Retrieve which pipelines are authorized to use the protected resources of your projects.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listPipelineResourcePermissions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "resource_id", Require: plugin.Optional},
+				{Name: "repository_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the protected resource. Possible values are: endpoint, variablegroup, securefile, queue, environment, repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the protected resource. For repositories, this is <project_id>.<repository_id>.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_id",
+				Description: "The ID of the repository, if the protected resource is a repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the protected resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "all_pipelines_authorized",
+				Description: "True if all the pipelines of the project are authorized to use the resource.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(pipelineResourcePermissionAllPipelinesAuthorized),
+			},
+			{
+				Name:        "all_pipelines_authorized_by_name",
+				Description: "The display name of the identity that authorized all the pipelines.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AllPipelines.AuthorizedBy.DisplayName"),
+			},
+			{
+				Name:        "all_pipelines_authorized_on",
+				Description: "The time all the pipelines were authorized.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AllPipelines.AuthorizedOn.Time"),
+			},
+			{
+				Name:        "pipeline_ids",
+				Description: "The IDs of the pipelines specifically authorized to use the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(pipelineResourcePermissionPipelineIds),
+			},
+			{
+				Name:        "pipelines",
+				Description: "The pipelines specifically authorized to use the resource, with who authorized them and when.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "all_pipelines",
+				Description: "The authorization of all the pipelines of the project.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+type PipelineResourcePermission struct {
+	pipelinepermissions.ResourcePipelinePermissions
+	ProjectId    string
+	ResourceType string
+	ResourceId   string
+	ResourceName *string
+	RepositoryId *string
+}
+
+// pipelineProtectedResource is a resource that pipelines must be authorized to use.
+type pipelineProtectedResource struct {
+	Id   string
+	Name *string
+}
+
+func listPipelineResourcePermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_resource_permission.listPipelineResourcePermissions", "connection_error", err)
+		return nil, err
+	}
+	client, err := pipelinepermissions.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_resource_permission.listPipelineResourcePermissions", "client_error", err)
+		return nil, err
+	}
+
+	resourceType := d.EqualsQuals["resource_type"].GetStringValue()
+	resourceId := d.EqualsQuals["resource_id"].GetStringValue()
+
+	// Repositories are identified by project and repository
+	if d.EqualsQuals["repository_id"] != nil {
+		repositoryResourceId := project.Id.String() + "." + d.EqualsQuals["repository_id"].GetStringValue()
+		if (resourceType != "" && resourceType != "repository") || (resourceId != "" && resourceId != repositoryResourceId) {
+			return nil, nil
+		}
+		resourceType = "repository"
+		resourceId = repositoryResourceId
+	}
+
+	for _, t := range pipelineProtectedResourceTypes {
+		if resourceType != "" && resourceType != t {
+			continue
+		}
+
+		resources, err := listPipelineProtectedResources(ctx, d, connection, project.Id.String(), t)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_pipeline_resource_permission.listPipelineResourcePermissions", "api_error", err)
+			return nil, err
+		}
+
+		for _, resource := range resources {
+			if resourceId != "" && resourceId != resource.Id {
+				continue
+			}
+
+			input := pipelinepermissions.GetPipelinePermissionsForResourceArgs{
+				Project:      types.String(project.Id.String()),
+				ResourceType: types.String(t),
+				ResourceId:   types.String(resource.Id),
+			}
+
+			permissions, err := client.GetPipelinePermissionsForResource(ctx, input)
+			if err != nil {
+				// The resource can be deleted while listing
+				if isNotFoundError(err) {
+					continue
+				}
+				plugin.Logger(ctx).Error("azuredevops_pipeline_resource_permission.listPipelineResourcePermissions", "api_error", err)
+				return nil, err
+			}
+
+			permission := PipelineResourcePermission{*permissions, project.Id.String(), t, resource.Id, resource.Name, nil}
+			if t == "repository" {
+				permission.RepositoryId = types.String(strings.TrimPrefix(resource.Id, project.Id.String()+"."))
+			}
+			d.StreamListItem(ctx, permission)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// listPipelineProtectedResources returns the resources of the given type in the project.
+func listPipelineProtectedResources(ctx context.Context, d *plugin.QueryData, connection *azuredevops.Connection, projectId string, resourceType string) ([]pipelineProtectedResource, error) {
+	var resources []pipelineProtectedResource
+
+	switch resourceType {
+	case "endpoint":
+		client, err := serviceendpoint.NewClient(ctx, connection)
+		if err != nil {
+			return nil, err
+		}
+		endpoints, err := client.GetServiceEndpoints(ctx, serviceendpoint.GetServiceEndpointsArgs{Project: types.String(projectId)})
+		if err != nil {
+			return nil, err
+		}
+		for _, endpoint := range *endpoints {
+			resources = append(resources, pipelineProtectedResource{endpoint.Id.String(), endpoint.Name})
+		}
+
+	case "variablegroup":
+		client, err := taskagent.NewClient(ctx, connection)
+		if err != nil {
+			return nil, err
+		}
+		groups, err := client.GetVariableGroups(ctx, taskagent.GetVariableGroupsArgs{Project: types.String(projectId)})
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range *groups {
+			resources = append(resources, pipelineProtectedResource{strconv.Itoa(*group.Id), group.Name})
+		}
+
+	case "securefile":
+		// The SDK has no client for secure files
+		resp, err := sendProjectRequest(ctx, connection, projectId, "_apis/distributedtask/securefiles", "6.0-preview.1", nil)
+		if err != nil {
+			return nil, err
+		}
+		var files []taskagent.SecureFile
+		err = connection.GetClientByUrl(connection.BaseUrl).UnmarshalCollectionBody(resp, &files)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			resources = append(resources, pipelineProtectedResource{file.Id.String(), file.Name})
+		}
+
+	case "queue":
+		client, err := taskagent.NewClient(ctx, connection)
+		if err != nil {
+			return nil, err
+		}
+		queues, err := client.GetAgentQueues(ctx, taskagent.GetAgentQueuesArgs{Project: types.String(projectId)})
+		if err != nil {
+			return nil, err
+		}
+		for _, queue := range *queues {
+			resources = append(resources, pipelineProtectedResource{strconv.Itoa(*queue.Id), queue.Name})
+		}
+
+	case "environment":
+		client, err := taskagent.NewClient(ctx, connection)
+		if err != nil {
+			return nil, err
+		}
+		environments, err := listProjectEnvironments(ctx, d, client, projectId)
+		if err != nil {
+			return nil, err
+		}
+		for _, environment := range environments {
+			resources = append(resources, pipelineProtectedResource{strconv.Itoa(*environment.Id), environment.Name})
+		}
+
+	case "repository":
+		client, err := git.NewClient(ctx, connection)
+		if err != nil {
+			return nil, err
+		}
+		repositories, err := client.GetRepositories(ctx, git.GetRepositoriesArgs{Project: types.String(projectId)})
+		if err != nil {
+			return nil, err
+		}
+		for _, repository := range *repositories {
+			// Repositories are identified by project and repository
+			resources = append(resources, pipelineProtectedResource{projectId + "." + repository.Id.String(), repository.Name})
+		}
+	}
+
+	return resources, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func pipelineResourcePermissionPipelineIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permission := d.HydrateItem.(PipelineResourcePermission)
+	ids := []int{}
+	if permission.Pipelines == nil {
+		return ids, nil
+	}
+	for _, pipeline := range *permission.Pipelines {
+		if pipeline.Id != nil && pipeline.Authorized != nil && *pipeline.Authorized {
+			ids = append(ids, *pipeline.Id)
+		}
+	}
+	return ids, nil
+}
+
+func pipelineResourcePermissionAllPipelinesAuthorized(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permission := d.HydrateItem.(PipelineResourcePermission)
+	return permission.AllPipelines != nil && permission.AllPipelines.Authorized != nil && *permission.AllPipelines.Authorized, nil
+}
@@ -0,0 +1,276 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelines"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The location of the runs resource of the Pipelines API
+var pipelineRunsLocationId = uuid.MustParse("7859261e-d2e9-4a68-b820-a5d84cc5bb3d")
+
+func tableAzureDevOpsPipelineRun(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_run",
+		Description: "Retrieve information about the runs of your pipelines.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listPipelineRuns,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "pipeline_id", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "pipeline_id", "project_id"}),
+			Hydrate:    getPipelineRun,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the run.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_id",
+				Description: "The ID of the pipeline.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Pipeline.Id"),
+			},
+			{
+				Name:        "pipeline_name",
+				Description: "The name of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Pipeline.Name"),
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the run. Possible values are: unknown, inProgress, canceling, completed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "result",
+				Description: "The result of the run. Possible values are: unknown, succeeded, failed, canceled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date the run was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedDate.Time"),
+			},
+			{
+				Name:        "finished_date",
+				Description: "The date the run finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FinishedDate.Time"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "final_yaml",
+				Description: "The final YAML of the run, with templates expanded.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipelineRun,
+			},
+			{
+				Name:        "template_parameters",
+				Description: "The template parameters the run was queued with.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineRun,
+			},
+			{
+				Name:        "variables",
+				Description: "The variables the run was queued with. Secret values are not returned.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineRun,
+			},
+			{
+				Name:        "repositories",
+				Description: "The repository resources of the run, by alias, with the ref and version (commit) used.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineRun,
+				Transform:   transform.FromField("Resources.Repositories"),
+			},
+			{
+				Name:        "pipelines",
+				Description: "The pipeline resources of the run, by alias, with the version (run) used.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineRun,
+				Transform:   transform.FromField("Resources.Pipelines"),
+			},
+			{
+				Name:        "containers",
+				Description: "The container resources of the run, by alias.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineRun,
+				Transform:   transform.FromField("Resources.Containers"),
+			},
+			{
+				Name:        "resources",
+				Description: "All the resources of the run.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineRun,
+			},
+			{
+				Name:        "links",
+				Description: "The class to represent a collection of REST reference links.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+// PipelineRun is a run as returned by the Pipelines API. The SDK model only
+// keeps the type of repository resources and drops template parameters.
+type PipelineRun struct {
+	pipelines.Run
+	Resources          *PipelineRunResources  `json:"resources,omitempty"`
+	TemplateParameters map[string]interface{} `json:"templateParameters,omitempty"`
+	ProjectId          string                 `json:"-"`
+}
+
+type PipelineRunResources struct {
+	Repositories map[string]interface{} `json:"repositories,omitempty"`
+	Pipelines    map[string]interface{} `json:"pipelines,omitempty"`
+	Containers   map[string]interface{} `json:"containers,omitempty"`
+	Builds       map[string]interface{} `json:"builds,omitempty"`
+	Packages     map[string]interface{} `json:"packages,omitempty"`
+}
+
+func listPipelineRuns(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run.listPipelineRuns", "connection_error", err)
+		return nil, err
+	}
+	client := pipelines.NewClient(ctx, connection)
+
+	// List the runs of the provided pipeline, or else of every pipeline of the project
+	var pipelineIds []int
+	if d.EqualsQuals["pipeline_id"] != nil {
+		pipelineIds = []int{int(d.EqualsQuals["pipeline_id"].GetInt64Value())}
+	} else {
+		projectPipelines, err := listProjectPipelines(ctx, connection, project.Id.String(), 0)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_pipeline_run.listPipelineRuns", "api_error", err)
+			return nil, err
+		}
+		for _, pipeline := range projectPipelines {
+			pipelineIds = append(pipelineIds, *pipeline.Id)
+		}
+	}
+
+	for _, pipelineId := range pipelineIds {
+		input := pipelines.ListRunsArgs{
+			Project:    types.String(project.Id.String()),
+			PipelineId: types.Int(pipelineId),
+		}
+
+		runs, err := client.ListRuns(ctx, input)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			plugin.Logger(ctx).Error("azuredevops_pipeline_run.listPipelineRuns", "api_error", err)
+			return nil, err
+		}
+
+		for _, run := range *runs {
+			d.StreamListItem(ctx, PipelineRun{Run: run, ProjectId: project.Id.String()})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func getPipelineRun(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var projectId string
+	var pipelineId, runId int
+	if h.Item != nil {
+		run := h.Item.(PipelineRun)
+		projectId = run.ProjectId
+		pipelineId = *run.Pipeline.Id
+		runId = *run.Id
+	} else {
+		projectId = d.EqualsQuals["project_id"].GetStringValue()
+		pipelineId = int(d.EqualsQuals["pipeline_id"].GetInt64Value())
+		runId = int(d.EqualsQuals["id"].GetInt64Value())
+	}
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run.getPipelineRun", "connection_error", err)
+		return nil, err
+	}
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Call the route of pipelines.GetRun directly to keep all the resources
+	routeValues := map[string]string{
+		"project":    projectId,
+		"pipelineId": strconv.Itoa(pipelineId),
+		"runId":      strconv.Itoa(runId),
+	}
+
+	resp, err := client.Send(ctx, http.MethodGet, pipelineRunsLocationId, "6.0-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run.getPipelineRun", "api_error", err)
+		return nil, err
+	}
+
+	run := PipelineRun{ProjectId: projectId}
+	err = client.UnmarshalBody(resp, &run)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run.getPipelineRun", "unmarshal_error", err)
+		return nil, err
+	}
+
+	return run, nil
+}
@@ -0,0 +1,182 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelines"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsPipelineRunArtifact(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_run_artifact",
+		Description: "Retrieve information about the artifacts published by your pipeline runs.",
+		List: &plugin.ListConfig{
+			Hydrate: listPipelineRunArtifacts,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "pipeline_id", Require: plugin.Required},
+				{Name: "run_id", Require: plugin.Required},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "run_id", "pipeline_id", "project_id"}),
+			Hydrate:    getPipelineRunArtifact,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the artifact.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "run_id",
+				Description: "The ID of the run.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "pipeline_id",
+				Description: "The ID of the pipeline.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "signed_content_url",
+				Description: "The signed URL for downloading the artifact.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipelineRunArtifact,
+				Transform:   transform.FromField("SignedContent.Url"),
+			},
+			{
+				Name:        "signed_content_expires",
+				Description: "The time the signed URL expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getPipelineRunArtifact,
+				Transform:   transform.FromField("SignedContent.SignatureExpires.Time"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the artifact.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipelineRunArtifact,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type PipelineRunArtifact struct {
+	pipelines.Artifact
+	RunId      int
+	PipelineId int
+	ProjectId  string
+}
+
+func listPipelineRunArtifacts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	pipelineId := int(d.EqualsQuals["pipeline_id"].GetInt64Value())
+	runId := int(d.EqualsQuals["run_id"].GetInt64Value())
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_artifact.listPipelineRunArtifacts", "connection_error", err)
+		return nil, err
+	}
+	client, err := build.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_artifact.listPipelineRunArtifacts", "client_error", err)
+		return nil, err
+	}
+
+	// The Pipelines API has no list operation for artifacts, but a run is
+	// backed by the build with the same ID
+	input := build.GetArtifactsArgs{
+		Project: types.String(projectId),
+		BuildId: types.Int(runId),
+	}
+
+	artifacts, err := client.GetArtifacts(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_artifact.listPipelineRunArtifacts", "api_error", err)
+		return nil, err
+	}
+
+	for _, artifact := range *artifacts {
+		d.StreamListItem(ctx, PipelineRunArtifact{pipelines.Artifact{Name: artifact.Name}, runId, pipelineId, projectId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getPipelineRunArtifact(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var projectId, name string
+	var pipelineId, runId int
+	if h.Item != nil {
+		artifact := h.Item.(PipelineRunArtifact)
+		projectId = artifact.ProjectId
+		pipelineId = artifact.PipelineId
+		runId = artifact.RunId
+		name = *artifact.Name
+	} else {
+		projectId = d.EqualsQuals["project_id"].GetStringValue()
+		pipelineId = int(d.EqualsQuals["pipeline_id"].GetInt64Value())
+		runId = int(d.EqualsQuals["run_id"].GetInt64Value())
+		name = d.EqualsQuals["name"].GetStringValue()
+	}
+
+	// Check if projectId or name is empty
+	if projectId == "" || name == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_artifact.getPipelineRunArtifact", "connection_error", err)
+		return nil, err
+	}
+	client := pipelines.NewClient(ctx, connection)
+
+	input := pipelines.GetArtifactArgs{
+		Project:      types.String(projectId),
+		PipelineId:   types.Int(pipelineId),
+		RunId:        types.Int(runId),
+		ArtifactName: types.String(name),
+		Expand:       &pipelines.GetArtifactExpandOptionsValues.SignedContent,
+	}
+
+	artifact, err := client.GetArtifact(ctx, input)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_artifact.getPipelineRunArtifact", "api_error", err)
+		return nil, err
+	}
+
+	return PipelineRunArtifact{*artifact, runId, pipelineId, projectId}, nil
+}
@@ -0,0 +1,184 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelines"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsPipelineRunLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_run_log",
+		Description: "Retrieve information about the logs of your pipeline runs.",
+		List: &plugin.ListConfig{
+			Hydrate: listPipelineRunLogs,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "pipeline_id", Require: plugin.Required},
+				{Name: "run_id", Require: plugin.Required},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "run_id", "pipeline_id", "project_id"}),
+			Hydrate:    getPipelineRunLog,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the log.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "run_id",
+				Description: "The ID of the run.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "pipeline_id",
+				Description: "The ID of the pipeline.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "line_count",
+				Description: "The number of lines in the log.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "created_on",
+				Description: "The date and time the log was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "last_changed_on",
+				Description: "The date and time the log was last changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastChangedOn.Time"),
+			},
+			{
+				Name:        "signed_content_url",
+				Description: "The signed URL for downloading the log content.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SignedContent.Url"),
+			},
+			{
+				Name:        "signed_content_expires",
+				Description: "The time the signed URL expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SignedContent.SignatureExpires.Time"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the log.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+type PipelineRunLog struct {
+	pipelines.Log
+	RunId      int
+	PipelineId int
+	ProjectId  string
+}
+
+func listPipelineRunLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	pipelineId := int(d.EqualsQuals["pipeline_id"].GetInt64Value())
+	runId := int(d.EqualsQuals["run_id"].GetInt64Value())
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_log.listPipelineRunLogs", "connection_error", err)
+		return nil, err
+	}
+	client := pipelines.NewClient(ctx, connection)
+
+	input := pipelines.ListLogsArgs{
+		Project:    types.String(projectId),
+		PipelineId: types.Int(pipelineId),
+		RunId:      types.Int(runId),
+		Expand:     &pipelines.GetLogExpandOptionsValues.SignedContent,
+	}
+
+	logs, err := client.ListLogs(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_log.listPipelineRunLogs", "api_error", err)
+		return nil, err
+	}
+	if logs == nil || logs.Logs == nil {
+		return nil, nil
+	}
+
+	for _, log := range *logs.Logs {
+		d.StreamListItem(ctx, PipelineRunLog{log, runId, pipelineId, projectId})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getPipelineRunLog(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logId := int(d.EqualsQuals["id"].GetInt64Value())
+	runId := int(d.EqualsQuals["run_id"].GetInt64Value())
+	pipelineId := int(d.EqualsQuals["pipeline_id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_log.getPipelineRunLog", "connection_error", err)
+		return nil, err
+	}
+	client := pipelines.NewClient(ctx, connection)
+
+	input := pipelines.GetLogArgs{
+		Project:    types.String(projectId),
+		PipelineId: types.Int(pipelineId),
+		RunId:      types.Int(runId),
+		LogId:      types.Int(logId),
+		Expand:     &pipelines.GetLogExpandOptionsValues.SignedContent,
+	}
+
+	log, err := client.GetLog(ctx, input)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_pipeline_run_log.getPipelineRunLog", "api_error", err)
+		return nil, err
+	}
+
+	return PipelineRunLog{*log, runId, pipelineId, projectId}, nil
+}
@@ -0,0 +1,569 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"gopkg.in/yaml.v3"
+)
+
+func tableAzureDevOpsPipelineYaml(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_yaml",
+		Description: "Retrieve the parsed YAML files of your YAML build definitions.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listPipelineYamls,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "definition_id", Require: plugin.Optional},
+				{Name: "repository_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "definition_id",
+				Description: "The ID of the build definition.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "definition_name",
+				Description: "The name of the build definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project this build definition belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project.Id"),
+			},
+			{
+				Name:        "repository_id",
+				Description: "ID of the repository the YAML file is read from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Repository.Id"),
+			},
+			{
+				Name:        "repository_type",
+				Description: "Type of the repository the YAML file is read from. Only Azure Repos Git (TfsGit) repositories are fetched.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Repository.Type"),
+			},
+			{
+				Name:        "yaml_filename",
+				Description: "The path of the YAML file in the repository.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("YamlFilename"),
+			},
+			{
+				Name:        "branch",
+				Description: "The branch the YAML file is read from, i.e. the default branch of the build definition.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Branch"),
+			},
+			{
+				Name:        "content",
+				Description: "The raw content of the YAML file.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Content"),
+			},
+			{
+				Name:        "error",
+				Description: "The reason the YAML file could not be fetched or parsed, if any.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Error"),
+			},
+			{
+				Name:        "tasks",
+				Description: "The tasks referenced by the YAML file, as name@version.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Tasks"),
+			},
+			{
+				Name:        "templates",
+				Description: "The templates referenced by the YAML file, with the alias and name of the repository resource they are read from.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Templates"),
+			},
+			{
+				Name:        "repository_resources",
+				Description: "The repository resources declared by the YAML file.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("RepositoryResources"),
+			},
+			{
+				Name:        "service_connections",
+				Description: "The service connections referenced by task inputs and resources of the YAML file.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("ServiceConnections"),
+			},
+			{
+				Name:        "variable_groups",
+				Description: "The variable groups referenced by the YAML file.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("VariableGroups"),
+			},
+			{
+				Name:        "pools",
+				Description: "The agent pools referenced by the YAML file.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Pools"),
+			},
+			{
+				Name:        "environments",
+				Description: "The environments targeted by deployment jobs of the YAML file.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Environments"),
+			},
+			{
+				Name:        "stages",
+				Description: "The stages of the YAML file.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Stages"),
+			},
+			{
+				Name:        "jobs",
+				Description: "The jobs and deployment jobs of the YAML file, with the stage they belong to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Jobs"),
+			},
+			{
+				Name:        "steps",
+				Description: "The steps of the YAML file, with the stage and job they belong to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPipelineYaml,
+				Transform:   transform.FromField("Steps"),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+// The YAML process type of build definitions
+const yamlProcessType = 2
+
+// Task inputs and resource properties which hold the name or ID of a service connection
+var serviceConnectionKeys = map[string]bool{
+	"azuresubscription":               true,
+	"azureresourcemanagerconnection":  true,
+	"connectedservicename":            true,
+	"connectedservicenamearm":         true,
+	"containerregistry":               true,
+	"dockerregistryserviceconnection": true,
+	"endpoint":                        true,
+	"externalendpoint":                true,
+	"externalendpoints":               true,
+	"githubconnection":                true,
+	"kubernetesserviceconnection":     true,
+	"kubernetesserviceendpoint":       true,
+	"serviceconnection":               true,
+}
+
+type PipelineYaml struct {
+	RepositoryId        *string
+	RepositoryType      *string
+	YamlFilename        *string
+	Branch              *string
+	Content             *string
+	Error               *string
+	Tasks               []string
+	Templates           []map[string]interface{}
+	RepositoryResources []interface{}
+	ServiceConnections  []string
+	VariableGroups      []string
+	Pools               []interface{}
+	Environments        []string
+	Stages              []map[string]interface{}
+	Jobs                []map[string]interface{}
+	Steps               []map[string]interface{}
+}
+
+func listPipelineYamls(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_yaml.listPipelineYamls", "connection_error", err)
+		return nil, err
+	}
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	// Call the route of build.GetDefinitions directly, since its response type
+	// drops the repository and process returned with includeAllProperties
+	routeValues := map[string]string{
+		"project": project.Id.String(),
+	}
+	queryParams := url.Values{}
+	queryParams.Add("$top", strconv.Itoa(maxLimit))
+	queryParams.Add("processType", strconv.Itoa(yamlProcessType))
+	queryParams.Add("includeAllProperties", "true")
+	if d.EqualsQuals["repository_id"] != nil {
+		queryParams.Add("repositoryId", d.EqualsQuals["repository_id"].GetStringValue())
+		queryParams.Add("repositoryType", "TfsGit")
+	}
+	if d.EqualsQuals["definition_id"] != nil {
+		queryParams.Add("definitionIds", strconv.Itoa(int(d.EqualsQuals["definition_id"].GetInt64Value())))
+	}
+
+	for {
+		resp, err := client.Send(ctx, http.MethodGet, buildDefinitionsLocationId, "6.0", routeValues, queryParams, nil, "", "application/json", nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_pipeline_yaml.listPipelineYamls", "api_error", err)
+			return nil, err
+		}
+
+		var definitions []build.BuildDefinition
+		err = client.UnmarshalCollectionBody(resp, &definitions)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_pipeline_yaml.listPipelineYamls", "unmarshal_error", err)
+			return nil, err
+		}
+
+		for _, definition := range definitions {
+			d.StreamListItem(ctx, definition)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		continuationToken := resp.Header.Get(azuredevops.HeaderKeyContinuationToken)
+		if continuationToken == "" {
+			break
+		}
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	return nil, nil
+}
+
+func getPipelineYaml(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	definition := h.Item.(build.BuildDefinition)
+
+	pipelineYaml := &PipelineYaml{}
+
+	// The YAML file name is only available on the untyped process
+	if process, ok := definition.Process.(map[string]interface{}); ok {
+		if yamlFilename, ok := process["yamlFilename"].(string); ok {
+			pipelineYaml.YamlFilename = types.String(yamlFilename)
+		}
+	}
+	if definition.Repository != nil {
+		pipelineYaml.RepositoryId = definition.Repository.Id
+		pipelineYaml.RepositoryType = definition.Repository.Type
+		if definition.Repository.DefaultBranch != nil {
+			pipelineYaml.Branch = definition.Repository.DefaultBranch
+		}
+	}
+
+	if pipelineYaml.YamlFilename == nil || pipelineYaml.RepositoryId == nil {
+		pipelineYaml.Error = types.String("the build definition does not reference a YAML file")
+		return pipelineYaml, nil
+	}
+	if pipelineYaml.RepositoryType == nil || *pipelineYaml.RepositoryType != "TfsGit" {
+		pipelineYaml.Error = types.String("the YAML file is not stored in an Azure Repos Git repository")
+		return pipelineYaml, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_yaml.getPipelineYaml", "connection_error", err)
+		return nil, err
+	}
+	client, err := git.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_yaml.getPipelineYaml", "client_error", err)
+		return nil, err
+	}
+
+	input := git.GetItemTextArgs{
+		RepositoryId: pipelineYaml.RepositoryId,
+		Path:         pipelineYaml.YamlFilename,
+	}
+	if pipelineYaml.Branch != nil {
+		input.VersionDescriptor = &git.GitVersionDescriptor{
+			Version:     types.String(strings.TrimPrefix(*pipelineYaml.Branch, "refs/heads/")),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		}
+	}
+
+	body, err := client.GetItemText(ctx, input)
+	if err != nil {
+		if isNotFoundError(err) {
+			pipelineYaml.Error = types.String("the YAML file was not found in the repository")
+			return pipelineYaml, nil
+		}
+		plugin.Logger(ctx).Error("azuredevops_pipeline_yaml.getPipelineYaml", "api_error", err)
+		return nil, err
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_pipeline_yaml.getPipelineYaml", "read_error", err)
+		return nil, err
+	}
+	pipelineYaml.Content = types.String(string(content))
+
+	var document interface{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		pipelineYaml.Error = types.String(err.Error())
+		return pipelineYaml, nil
+	}
+	pipelineYaml.parse(document)
+
+	return pipelineYaml, nil
+}
+
+// parse extracts the referenced tasks, templates and resources from a decoded YAML document
+func (p *PipelineYaml) parse(document interface{}) {
+	root, ok := document.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	// Repository resources are needed to resolve template references
+	repositoryNames := map[string]interface{}{}
+	if resources, ok := root["resources"].(map[string]interface{}); ok {
+		if repositories, ok := resources["repositories"].([]interface{}); ok {
+			p.RepositoryResources = repositories
+			for _, repository := range repositories {
+				if repository, ok := repository.(map[string]interface{}); ok {
+					if alias, ok := repository["repository"].(string); ok {
+						repositoryNames[alias] = repository["name"]
+					}
+				}
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	p.walk(root, "", "", repositoryNames, seen)
+}
+
+func (p *PipelineYaml) walk(node interface{}, stage string, job string, repositoryNames map[string]interface{}, seen map[string]bool) {
+	switch node := node.(type) {
+	case []interface{}:
+		for _, item := range node {
+			p.walk(item, stage, job, repositoryNames, seen)
+		}
+	case map[string]interface{}:
+		if task, ok := node["task"].(string); ok && !seen["task:"+task] {
+			seen["task:"+task] = true
+			p.Tasks = append(p.Tasks, task)
+		}
+		if template, ok := node["template"].(string); ok && !seen["template:"+template] {
+			seen["template:"+template] = true
+			reference := map[string]interface{}{"path": template}
+			if i := strings.LastIndex(template, "@"); i > 0 {
+				alias := template[i+1:]
+				reference["path"] = template[:i]
+				reference["repository_alias"] = alias
+				if alias != "self" {
+					reference["repository"] = repositoryNames[alias]
+				}
+			}
+			p.Templates = append(p.Templates, reference)
+		}
+
+		// Visit the keys in a stable order so array columns are deterministic
+		keys := make([]string, 0, len(node))
+		for key := range node {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			value := node[key]
+			switch {
+			case serviceConnectionKeys[strings.ToLower(key)]:
+				if name, ok := value.(string); ok && !seen["connection:"+name] {
+					seen["connection:"+name] = true
+					p.ServiceConnections = append(p.ServiceConnections, name)
+				}
+			case key == "pool":
+				data, _ := json.Marshal(value)
+				if !seen["pool:"+string(data)] {
+					seen["pool:"+string(data)] = true
+					p.Pools = append(p.Pools, value)
+				}
+			case key == "environment":
+				name, ok := value.(string)
+				if environment, isMap := value.(map[string]interface{}); isMap {
+					name, ok = environment["name"].(string)
+				}
+				if ok && !seen["environment:"+name] {
+					seen["environment:"+name] = true
+					p.Environments = append(p.Environments, name)
+				}
+			case key == "variables":
+				if variables, ok := value.([]interface{}); ok {
+					for _, variable := range variables {
+						if variable, ok := variable.(map[string]interface{}); ok {
+							if group, ok := variable["group"].(string); ok && !seen["group:"+group] {
+								seen["group:"+group] = true
+								p.VariableGroups = append(p.VariableGroups, group)
+							}
+						}
+					}
+				}
+			case key == "stages":
+				if stages, ok := value.([]interface{}); ok {
+					for _, item := range stages {
+						name := p.addStage(item)
+						p.walk(item, name, "", repositoryNames, seen)
+					}
+					continue
+				}
+			case key == "jobs":
+				if jobs, ok := value.([]interface{}); ok {
+					for _, item := range jobs {
+						name := p.addJob(item, stage)
+						p.walk(item, stage, name, repositoryNames, seen)
+					}
+					continue
+				}
+			case key == "steps":
+				if steps, ok := value.([]interface{}); ok {
+					for _, item := range steps {
+						p.addStep(item, stage, job)
+					}
+				}
+			}
+			p.walk(value, stage, job, repositoryNames, seen)
+		}
+	}
+}
+
+func (p *PipelineYaml) addStage(item interface{}) string {
+	stage, ok := item.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	name, _ := stage["stage"].(string)
+	p.Stages = append(p.Stages, map[string]interface{}{
+		"stage":        stage["stage"],
+		"display_name": stage["displayName"],
+		"depends_on":   stage["dependsOn"],
+		"condition":    stage["condition"],
+		"template":     stage["template"],
+	})
+	return name
+}
+
+func (p *PipelineYaml) addJob(item interface{}, stage string) string {
+	job, ok := item.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	entry := map[string]interface{}{
+		"stage":        nullIfEmpty(stage),
+		"display_name": job["displayName"],
+		"depends_on":   job["dependsOn"],
+		"condition":    job["condition"],
+		"pool":         job["pool"],
+	}
+	var name string
+	switch {
+	case job["deployment"] != nil:
+		name, _ = job["deployment"].(string)
+		entry["type"] = "deployment"
+		entry["environment"] = job["environment"]
+	case job["template"] != nil:
+		entry["type"] = "template"
+		entry["template"] = job["template"]
+	default:
+		name, _ = job["job"].(string)
+		entry["type"] = "job"
+	}
+	entry["job"] = nullIfEmpty(name)
+	p.Jobs = append(p.Jobs, entry)
+	return name
+}
+
+// Step types that are shortcuts for a task or a command
+var stepTypes = []string{"task", "script", "bash", "pwsh", "powershell", "checkout", "download", "downloadBuild", "getPackage", "publish", "reviewApp", "template", "restoreCache", "saveCache"}
+
+func (p *PipelineYaml) addStep(item interface{}, stage string, job string) {
+	step, ok := item.(map[string]interface{})
+	if !ok {
+		return
+	}
+	entry := map[string]interface{}{
+		"stage":        nullIfEmpty(stage),
+		"job":          nullIfEmpty(job),
+		"name":         step["name"],
+		"display_name": step["displayName"],
+		"condition":    step["condition"],
+	}
+	for _, stepType := range stepTypes {
+		if value, ok := step[stepType]; ok {
+			entry["type"] = stepType
+			entry["value"] = value
+			break
+		}
+	}
+	if inputs, ok := step["inputs"]; ok {
+		entry["inputs"] = inputs
+	}
+	p.Steps = append(p.Steps, entry)
+}
+
+func nullIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
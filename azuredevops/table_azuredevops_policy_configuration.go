@@ -0,0 +1,318 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The location of the policy configurations resource of the Policy API
+var policyConfigurationsLocationId = uuid.MustParse("dad91cbe-d183-45f8-9c6e-9c1164472121")
+
+func tableAzureDevOpsPolicyConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_policy_configuration",
+		Description: "Retrieve information about your branch policy configurations.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listPolicyConfigurations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "type_id", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "project_id"}),
+			Hydrate:    getPolicyConfiguration,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The policy configuration ID.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project this policy configuration belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The policy type, e.g. minimum_reviewers, build_validation, comment_resolution, work_item_linking, required_reviewers, file_size or path_length. Unknown types are reported as other.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyType"),
+			},
+			{
+				Name:        "type_id",
+				Description: "The policy type ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type.Id"),
+			},
+			{
+				Name:        "type_display_name",
+				Description: "Display name of the policy type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type.DisplayName"),
+			},
+			{
+				Name:        "is_enabled",
+				Description: "Indicates whether the policy is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_blocking",
+				Description: "Indicates whether the policy is blocking.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_deleted",
+				Description: "Indicates whether the policy has been (soft) deleted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_enterprise_managed",
+				Description: "If set, this policy requires \"Manage Enterprise Policies\" permission to create, edit, or delete.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "scope_repository_id",
+				Description: "The repository ID of the first policy scope. Null if the policy applies to all repositories in the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope_ref_name",
+				Description: "The ref name of the first policy scope, e.g. refs/heads/main.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope_match_kind",
+				Description: "The match kind of the first policy scope, e.g. Exact or Prefix.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time when the policy was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedDate.Time"),
+			},
+			{
+				Name:        "revision",
+				Description: "The policy configuration revision ID.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "url",
+				Description: "The URL where the policy configuration can be retrieved.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_by",
+				Description: "A reference to the identity that created the policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "links",
+				Description: "The links to other objects related to this object.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "scope",
+				Description: "All scopes (repository, ref name and match kind) the policy applies to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Settings.scope"),
+			},
+			{
+				Name:        "settings",
+				Description: "The policy configuration settings.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type.DisplayName"),
+			},
+		}),
+	}
+}
+
+// Well-known policy type IDs, see https://learn.microsoft.com/en-us/azure/devops/repos/git/branch-policies
+var policyTypeNames = map[string]string{
+	"fa4e907d-c16b-4a4c-9dfa-4906e5d171dd": "minimum_reviewers",
+	"0609b952-1397-4640-95ec-e00a01b2c241": "build_validation",
+	"c6a1889d-b943-4856-b76f-9e46bb6b0df2": "comment_resolution",
+	"40e92b44-2fe1-4dd6-b3d8-74a9c21d0c6e": "work_item_linking",
+	"fd2167ab-b0be-447a-8ec8-39368250530e": "required_reviewers",
+	"2e26e725-8201-4edd-8bf5-978563c34a80": "file_size",
+	"001a79cf-f74c-4ea2-b20d-2e6e4d4ef6dc": "path_length",
+	"fa4e907d-c16b-4a4c-9dfa-4916e5d171ab": "merge_strategy",
+	"cbdc66da-9728-4af8-aada-9a5a32e4a226": "status",
+	"51c78909-e838-41a2-9496-c647091e3c61": "file_name",
+	"db2b9b4c-180d-4529-9701-01541d19f36b": "reserved_names",
+	"77ed4bd3-b063-4689-934a-175e4d0a78d7": "commit_author_email",
+	"7ed39669-655c-494e-b4a0-a08b4da0fcce": "git_repository_settings",
+}
+
+type PolicyConfiguration struct {
+	policy.PolicyConfiguration
+	ProjectId         string
+	PolicyType        string
+	ScopeRepositoryId *string
+	ScopeRefName      *string
+	ScopeMatchKind    *string
+}
+
+func newPolicyConfiguration(configuration policy.PolicyConfiguration, projectId string) PolicyConfiguration {
+	item := PolicyConfiguration{PolicyConfiguration: configuration, ProjectId: projectId, PolicyType: "other"}
+	if configuration.Type != nil && configuration.Type.Id != nil {
+		if name, ok := policyTypeNames[configuration.Type.Id.String()]; ok {
+			item.PolicyType = name
+		}
+	}
+
+	// Settings are returned as free-form JSON; only the first scope is flattened
+	settings, ok := configuration.Settings.(map[string]interface{})
+	if !ok {
+		return item
+	}
+	scopes, ok := settings["scope"].([]interface{})
+	if !ok || len(scopes) == 0 {
+		return item
+	}
+	scope, ok := scopes[0].(map[string]interface{})
+	if !ok {
+		return item
+	}
+	if repositoryId, ok := scope["repositoryId"].(string); ok {
+		item.ScopeRepositoryId = types.String(repositoryId)
+	}
+	if refName, ok := scope["refName"].(string); ok {
+		item.ScopeRefName = types.String(refName)
+	}
+	if matchKind, ok := scope["matchKind"].(string); ok {
+		item.ScopeMatchKind = types.String(matchKind)
+	}
+
+	return item
+}
+
+func listPolicyConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_policy_configuration.listPolicyConfigurations", "connection_error", err)
+		return nil, err
+	}
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Limiting the results
+	maxLimit := 1000
+	if d.QueryContext.Limit != nil {
+		limit := int(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	// Call the route of policy.GetPolicyConfigurations directly, since its
+	// arguments do not support paging
+	routeValues := map[string]string{
+		"project": project.Id.String(),
+	}
+	queryParams := url.Values{}
+	queryParams.Add("$top", strconv.Itoa(maxLimit))
+	if d.EqualsQuals["type_id"] != nil {
+		typeId, err := uuid.Parse(d.EqualsQuals["type_id"].GetStringValue())
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_policy_configuration.listPolicyConfigurations", "invalid_type_id", err)
+			return nil, fmt.Errorf("invalid type_id %q: %v", d.EqualsQuals["type_id"].GetStringValue(), err)
+		}
+		queryParams.Add("policyType", typeId.String())
+	}
+
+	for {
+		resp, err := client.Send(ctx, http.MethodGet, policyConfigurationsLocationId, "6.0", routeValues, queryParams, nil, "", "application/json", nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_policy_configuration.listPolicyConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		var configurations []policy.PolicyConfiguration
+		err = client.UnmarshalCollectionBody(resp, &configurations)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_policy_configuration.listPolicyConfigurations", "unmarshal_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range configurations {
+			d.StreamListItem(ctx, newPolicyConfiguration(configuration, project.Id.String()))
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		continuationToken := resp.Header.Get(azuredevops.HeaderKeyContinuationToken)
+		if continuationToken == "" {
+			break
+		}
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	return nil, nil
+}
+
+func getPolicyConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	configurationId := d.EqualsQuals["id"].GetInt64Value()
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_policy_configuration.getPolicyConfiguration", "connection_error", err)
+		return nil, err
+	}
+	client, err := policy.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_policy_configuration.getPolicyConfiguration", "client_error", err)
+		return nil, err
+	}
+
+	input := policy.GetPolicyConfigurationArgs{
+		Project:         types.String(projectId),
+		ConfigurationId: types.Int(int(configurationId)),
+	}
+
+	configuration, err := client.GetPolicyConfiguration(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_policy_configuration.getPolicyConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	return newPolicyConfiguration(*configuration, projectId), nil
+}
@@ -0,0 +1,219 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsVariableGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_variable_group",
+		Description: "Retrieve information about the variable groups of your projects.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listVariableGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"id", "project_id"}),
+			Hydrate:    getVariableGroup,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the variable group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the variable group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the variable group. Possible values are: Vsts, AzureKeyVault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the variable group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_shared",
+				Description: "True if the variable group is shared with other projects.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "key_vault_name",
+				Description: "The name of the linked key vault, for AzureKeyVault variable groups.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProviderData.vault"),
+			},
+			{
+				Name:        "service_endpoint_id",
+				Description: "The ID of the service connection used to access the linked key vault, for AzureKeyVault variable groups.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProviderData.serviceEndpointId"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The time the variable group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "created_by_id",
+				Description: "The ID of the identity that created the variable group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.Id"),
+			},
+			{
+				Name:        "created_by_name",
+				Description: "The display name of the identity that created the variable group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.DisplayName"),
+			},
+			{
+				Name:        "modified_on",
+				Description: "The time the variable group was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifiedOn.Time"),
+			},
+			{
+				Name:        "modified_by_id",
+				Description: "The ID of the identity that last modified the variable group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ModifiedBy.Id"),
+			},
+			{
+				Name:        "modified_by_name",
+				Description: "The display name of the identity that last modified the variable group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ModifiedBy.DisplayName"),
+			},
+			{
+				Name:        "provider_data",
+				Description: "The data of the provider of the variable group, e.g. the linked key vault.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "project_references",
+				Description: "The projects the variable group is shared with.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VariableGroupProjectReferences"),
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type VariableGroup struct {
+	taskagent.VariableGroup
+	ProjectId string
+}
+
+func listVariableGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group.listVariableGroups", "connection_error", err)
+		return nil, err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group.listVariableGroups", "client_error", err)
+		return nil, err
+	}
+
+	input := taskagent.GetVariableGroupsArgs{
+		Project: types.String(project.Id.String()),
+	}
+	if d.EqualsQuals["name"] != nil {
+		input.GroupName = types.String(d.EqualsQuals["name"].GetStringValue())
+	}
+
+	variableGroups, err := client.GetVariableGroups(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group.listVariableGroups", "api_error", err)
+		return nil, err
+	}
+
+	for _, variableGroup := range *variableGroups {
+		d.StreamListItem(ctx, VariableGroup{variableGroup, project.Id.String()})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getVariableGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	variableGroupId := int(d.EqualsQuals["id"].GetInt64Value())
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+
+	// Check if projectId is empty
+	if projectId == "" {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group.getVariableGroup", "connection_error", err)
+		return nil, err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group.getVariableGroup", "client_error", err)
+		return nil, err
+	}
+
+	input := taskagent.GetVariableGroupArgs{
+		Project: types.String(projectId),
+		GroupId: types.Int(variableGroupId),
+	}
+
+	variableGroup, err := client.GetVariableGroup(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group.getVariableGroup", "api_error", err)
+		return nil, err
+	}
+
+	// The API returns no content if the variable group does not exist
+	if variableGroup == nil || variableGroup.Id == nil {
+		return nil, nil
+	}
+
+	return VariableGroup{*variableGroup, projectId}, nil
+}
@@ -0,0 +1,177 @@
+package azuredevops
+
+import (
+	"context"
+	"sort"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsVariableGroupVariable(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_variable_group_variable",
+		Description: "Retrieve information about the variables of your variable groups. Secret values are never returned.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listVariableGroupVariables,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "variable_group_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the variable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "variable_group_id",
+				Description: "The ID of the variable group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "variable_group_name",
+				Description: "The name of the variable group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_secret",
+				Description: "True if the value of the variable is secret.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_read_only",
+				Description: "True if the value of the variable cannot be changed at queue time.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the variable. Null for secret variables, or for all variables if redact_values is set in the connection config.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+type VariableGroupVariable struct {
+	Name              string
+	VariableGroupId   int
+	VariableGroupName *string
+	ProjectId         string
+	IsSecret          bool
+	IsReadOnly        bool
+	Value             *string
+}
+
+func listVariableGroupVariables(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group_variable.listVariableGroupVariables", "connection_error", err)
+		return nil, err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group_variable.listVariableGroupVariables", "client_error", err)
+		return nil, err
+	}
+
+	var variableGroups *[]taskagent.VariableGroup
+	if d.EqualsQuals["variable_group_id"] != nil {
+		variableGroups, err = client.GetVariableGroupsById(ctx, taskagent.GetVariableGroupsByIdArgs{
+			Project:  types.String(project.Id.String()),
+			GroupIds: &[]int{int(d.EqualsQuals["variable_group_id"].GetInt64Value())},
+		})
+	} else {
+		variableGroups, err = client.GetVariableGroups(ctx, taskagent.GetVariableGroupsArgs{
+			Project: types.String(project.Id.String()),
+		})
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_group_variable.listVariableGroupVariables", "api_error", err)
+		return nil, err
+	}
+	if variableGroups == nil {
+		return nil, nil
+	}
+
+	redact := shouldRedactValues(d)
+
+	for _, variableGroup := range *variableGroups {
+		for _, variable := range getVariableGroupVariables(VariableGroup{variableGroup, project.Id.String()}) {
+			if variable.IsSecret || redact {
+				variable.Value = nil
+			}
+			d.StreamListItem(ctx, variable)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getVariableGroupVariables returns the variables of the variable group sorted
+// by name, with the values of the variables that are not secret.
+func getVariableGroupVariables(variableGroup VariableGroup) []VariableGroupVariable {
+	if variableGroup.Variables == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(*variableGroup.Variables))
+	for name := range *variableGroup.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	variables := make([]VariableGroupVariable, 0, len(names))
+	for _, name := range names {
+		variable := VariableGroupVariable{
+			Name:              name,
+			VariableGroupId:   *variableGroup.Id,
+			VariableGroupName: variableGroup.Name,
+			ProjectId:         variableGroup.ProjectId,
+		}
+
+		// Variables are returned as objects with value, isSecret and isReadOnly
+		if properties, ok := (*variableGroup.Variables)[name].(map[string]interface{}); ok {
+			variable.IsSecret, _ = properties["isSecret"].(bool)
+			variable.IsReadOnly, _ = properties["isReadOnly"].(bool)
+			if value, ok := properties["value"].(string); ok && !variable.IsSecret {
+				variable.Value = &value
+			}
+		}
+		variables = append(variables, variable)
+	}
+
+	return variables
+}
@@ -0,0 +1,493 @@
+package azuredevops
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/release"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAzureDevOpsVariableSecretFinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_variable_secret_finding",
+		Description: "Retrieve non-secret pipeline variables whose values look like credentials. Variable values are never returned.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listVariableSecretFindings,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "source_type", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_type",
+				Description: "The type of the object that defines the variable. Possible values are build_definition, release_definition and variable_group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_id",
+				Description: "The ID of the build definition, release definition or variable group that defines the variable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_name",
+				Description: "The name of the build definition, release definition or variable group that defines the variable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The name of the release stage that defines the variable. Null if the variable is defined at the top level of its source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "variable_name",
+				Description: "The name of the variable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detector",
+				Description: "The name of the detector that matched the variable value, e.g. azure_devops_pat, azure_storage_key, aws_access_key_id, private_key or high_entropy_string.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of what the detector looks for.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			/// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "Title of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VariableName"),
+			},
+		}),
+	}
+}
+
+type VariableSecretFinding struct {
+	ProjectId    string
+	SourceType   string
+	SourceId     string
+	SourceName   *string
+	Scope        *string
+	VariableName string
+	Detector     string
+	Description  string
+}
+
+const (
+	variableSecretFindingSourceBuildDefinition   = "build_definition"
+	variableSecretFindingSourceReleaseDefinition = "release_definition"
+	variableSecretFindingSourceVariableGroup     = "variable_group"
+)
+
+func listVariableSecretFindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(core.TeamProjectReference)
+	project_id := d.EqualsQuals["project_id"].GetStringValue()
+	source_type := d.EqualsQuals["source_type"].GetStringValue()
+
+	// check if the provided project_id is not matching with the parentHydrate
+	if project_id != "" && project_id != project.Id.String() {
+		return nil, nil
+	}
+
+	scanners := []struct {
+		sourceType string
+		scan       func(context.Context, *plugin.QueryData, string) error
+	}{
+		{variableSecretFindingSourceBuildDefinition, scanBuildDefinitionVariables},
+		{variableSecretFindingSourceReleaseDefinition, scanReleaseDefinitionVariables},
+		{variableSecretFindingSourceVariableGroup, scanVariableGroupVariables},
+	}
+
+	for _, scanner := range scanners {
+		if source_type != "" && source_type != scanner.sourceType {
+			continue
+		}
+
+		err := scanner.scan(ctx, d, project.Id.String())
+		if err != nil {
+			return nil, err
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// streamVariableSecretFindings streams a finding per detector matching the
+// variable, and returns false once no more rows are needed.
+func streamVariableSecretFindings(ctx context.Context, d *plugin.QueryData, finding VariableSecretFinding, value *string) bool {
+	for _, detector := range detectSecrets(finding.VariableName, value) {
+		finding.Detector = detector.name
+		finding.Description = detector.description
+		d.StreamListItem(ctx, finding)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func scanBuildDefinitionVariables(ctx context.Context, d *plugin.QueryData, projectId string) error {
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanBuildDefinitionVariables", "connection_error", err)
+		return err
+	}
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	// Call the route of build.GetDefinitions directly, since its response type
+	// drops the variables returned with includeAllProperties
+	routeValues := map[string]string{
+		"project": projectId,
+	}
+	queryParams := url.Values{}
+	queryParams.Add("includeAllProperties", "true")
+
+	for {
+		resp, err := client.Send(ctx, http.MethodGet, buildDefinitionsLocationId, "6.0", routeValues, queryParams, nil, "", "application/json", nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanBuildDefinitionVariables", "api_error", err)
+			return err
+		}
+
+		var definitions []build.BuildDefinition
+		err = client.UnmarshalCollectionBody(resp, &definitions)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanBuildDefinitionVariables", "unmarshal_error", err)
+			return err
+		}
+
+		for _, definition := range definitions {
+			if definition.Variables == nil {
+				continue
+			}
+
+			for _, name := range sortedKeys(*definition.Variables) {
+				variable := (*definition.Variables)[name]
+				if variable.IsSecret != nil && *variable.IsSecret {
+					continue
+				}
+				finding := VariableSecretFinding{
+					ProjectId:    projectId,
+					SourceType:   variableSecretFindingSourceBuildDefinition,
+					SourceId:     strconv.Itoa(*definition.Id),
+					SourceName:   definition.Name,
+					VariableName: name,
+				}
+				if !streamVariableSecretFindings(ctx, d, finding, variable.Value) {
+					return nil
+				}
+			}
+		}
+
+		continuationToken := resp.Header.Get(azuredevops.HeaderKeyContinuationToken)
+		if continuationToken == "" {
+			break
+		}
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	return nil
+}
+
+func scanReleaseDefinitionVariables(ctx context.Context, d *plugin.QueryData, projectId string) error {
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanReleaseDefinitionVariables", "connection_error", err)
+		return err
+	}
+	client, err := release.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanReleaseDefinitionVariables", "client_error", err)
+		return err
+	}
+
+	expand := release.ReleaseDefinitionExpands(string(release.ReleaseDefinitionExpandsValues.Environments) + "," + string(release.ReleaseDefinitionExpandsValues.Variables))
+	input := release.GetReleaseDefinitionsArgs{
+		Project: types.String(projectId),
+		Expand:  &expand,
+	}
+
+	for {
+		definitions, err := client.GetReleaseDefinitions(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanReleaseDefinitionVariables", "api_error", err)
+			return err
+		}
+
+		for _, definition := range definitions.Value {
+			// The variables of the definition are scanned first, then those of each stage
+			scopes := []struct {
+				name      *string
+				variables *map[string]release.ConfigurationVariableValue
+			}{
+				{nil, definition.Variables},
+			}
+			if definition.Environments != nil {
+				for _, environment := range *definition.Environments {
+					scopes = append(scopes, struct {
+						name      *string
+						variables *map[string]release.ConfigurationVariableValue
+					}{environment.Name, environment.Variables})
+				}
+			}
+
+			for _, scope := range scopes {
+				if scope.variables == nil {
+					continue
+				}
+
+				for _, name := range sortedKeys(*scope.variables) {
+					variable := (*scope.variables)[name]
+					if variable.IsSecret != nil && *variable.IsSecret {
+						continue
+					}
+					finding := VariableSecretFinding{
+						ProjectId:    projectId,
+						SourceType:   variableSecretFindingSourceReleaseDefinition,
+						SourceId:     strconv.Itoa(*definition.Id),
+						SourceName:   definition.Name,
+						Scope:        scope.name,
+						VariableName: name,
+					}
+					if !streamVariableSecretFindings(ctx, d, finding, variable.Value) {
+						return nil
+					}
+				}
+			}
+		}
+
+		input.ContinuationToken = types.String(definitions.ContinuationToken)
+		if definitions.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil
+}
+
+func scanVariableGroupVariables(ctx context.Context, d *plugin.QueryData, projectId string) error {
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanVariableGroupVariables", "connection_error", err)
+		return err
+	}
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanVariableGroupVariables", "client_error", err)
+		return err
+	}
+
+	variableGroups, err := client.GetVariableGroups(ctx, taskagent.GetVariableGroupsArgs{
+		Project: types.String(projectId),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azuredevops_variable_secret_finding.scanVariableGroupVariables", "api_error", err)
+		return err
+	}
+
+	for _, variableGroup := range *variableGroups {
+		for _, variable := range getVariableGroupVariables(VariableGroup{variableGroup, projectId}) {
+			if variable.IsSecret {
+				continue
+			}
+			finding := VariableSecretFinding{
+				ProjectId:    projectId,
+				SourceType:   variableSecretFindingSourceVariableGroup,
+				SourceId:     strconv.Itoa(variable.VariableGroupId),
+				SourceName:   variable.VariableGroupName,
+				VariableName: variable.Name,
+			}
+			if !streamVariableSecretFindings(ctx, d, finding, variable.Value) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortedKeys returns the keys of the variables map in order, so that the
+// findings are returned in a stable order.
+func sortedKeys[V any](variables map[string]V) []string {
+	keys := make([]string, 0, len(variables))
+	for key := range variables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+//// SECRET DETECTORS
+
+type secretDetector struct {
+	name        string
+	description string
+	match       func(name, value string) bool
+}
+
+var (
+	azureDevOpsPatRegexp       = regexp.MustCompile(`(^|[^a-z0-9])[a-z2-7]{52}([^a-z0-9]|$)`)
+	azureDevOpsPatV2Regexp     = regexp.MustCompile(`(^|[^A-Za-z0-9])[A-Za-z0-9]{76}AZDO[A-Za-z0-9]{4}([^A-Za-z0-9]|$)`)
+	azureStorageKeyRegexp      = regexp.MustCompile(`(^|[^A-Za-z0-9+/])[A-Za-z0-9+/]{86}==`)
+	awsAccessKeyIdRegexp       = regexp.MustCompile(`(^|[^A-Z0-9])(AKIA|ASIA)[A-Z0-9]{16}([^A-Z0-9]|$)`)
+	awsSecretAccessKeyRegexp   = regexp.MustCompile(`^[A-Za-z0-9/+]{40}$`)
+	privateKeyRegexp           = regexp.MustCompile(`-----BEGIN ([A-Z]+ )*PRIVATE KEY( BLOCK)?-----`)
+	gitHubTokenRegexp          = regexp.MustCompile(`(^|[^A-Za-z0-9_])(gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{82})([^A-Za-z0-9_]|$)`)
+	pipelineVariableRefsRegexp = regexp.MustCompile(`\$\([^)]*\)|\$\{\{.*?\}\}|\$\[.*?\]`)
+)
+
+// secretDetectors are evaluated in order. The high entropy detector only
+// reports values that did not match a known token format.
+var secretDetectors = []secretDetector{
+	{
+		name:        "azure_devops_pat",
+		description: "Azure DevOps personal access token.",
+		match: func(_, value string) bool {
+			return azureDevOpsPatRegexp.MatchString(value) || azureDevOpsPatV2Regexp.MatchString(value)
+		},
+	},
+	{
+		name:        "azure_storage_key",
+		description: "Azure storage account key, on its own or in a connection string.",
+		match: func(_, value string) bool {
+			return azureStorageKeyRegexp.MatchString(value)
+		},
+	},
+	{
+		name:        "aws_access_key_id",
+		description: "AWS access key ID.",
+		match: func(_, value string) bool {
+			return awsAccessKeyIdRegexp.MatchString(value)
+		},
+	},
+	{
+		name:        "aws_secret_access_key",
+		description: "AWS secret access key in a variable whose name refers to AWS.",
+		match: func(name, value string) bool {
+			return strings.Contains(strings.ToLower(name), "aws") && awsSecretAccessKeyRegexp.MatchString(value)
+		},
+	},
+	{
+		name:        "private_key",
+		description: "PEM encoded private key.",
+		match: func(_, value string) bool {
+			return privateKeyRegexp.MatchString(value)
+		},
+	},
+	{
+		name:        "github_token",
+		description: "GitHub personal access, OAuth or app token.",
+		match: func(_, value string) bool {
+			return gitHubTokenRegexp.MatchString(value)
+		},
+	},
+}
+
+var highEntropySecretDetector = secretDetector{
+	name:        "high_entropy_string",
+	description: "Random looking string of at least 20 characters that may be a password, key or token.",
+	match: func(_, value string) bool {
+		return isHighEntropyString(value)
+	},
+}
+
+// detectSecrets returns the detectors that match the value of a variable.
+// References to other variables, e.g. AccountKey=$(storageKey), are not secrets
+// and are replaced by a space before matching.
+func detectSecrets(name string, value *string) []secretDetector {
+	if value == nil {
+		return nil
+	}
+	text := pipelineVariableRefsRegexp.ReplaceAllString(*value, " ")
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+
+	var matched []secretDetector
+	for _, detector := range secretDetectors {
+		if detector.match(name, text) {
+			matched = append(matched, detector)
+		}
+	}
+	if len(matched) == 0 && highEntropySecretDetector.match(name, text) {
+		matched = append(matched, highEntropySecretDetector)
+	}
+
+	return matched
+}
+
+// isHighEntropyString reports whether the value is a single token of mixed
+// letters and digits with a Shannon entropy of at least 4.2 bits per character.
+// URLs are ignored.
+func isHighEntropyString(value string) bool {
+	if len(value) < 20 || strings.Contains(value, "://") {
+		return false
+	}
+
+	var hasLetter, hasDigit bool
+	for _, r := range value {
+		switch {
+		case unicode.IsSpace(r):
+			return false
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLetter || !hasDigit {
+		return false
+	}
+
+	return shannonEntropy(value) >= 4.2
+}
+
+func shannonEntropy(value string) float64 {
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range value {
+		counts[r]++
+		total++
+	}
+
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
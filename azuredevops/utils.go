@@ -0,0 +1,105 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/location"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// getWrappedErrorStatus returns the HTTP status code of an API error along with
+// the error, or 0 if err is not an API error.
+// The client returns WrappedError both by value and by pointer.
+func getWrappedErrorStatus(err error) (int, *azuredevops.WrappedError) {
+	var wrappedError azuredevops.WrappedError
+	var wrappedErrorPtr *azuredevops.WrappedError
+	if errors.As(err, &wrappedErrorPtr) {
+		wrappedError = *wrappedErrorPtr
+	} else if !errors.As(err, &wrappedError) {
+		return 0, nil
+	}
+	if wrappedError.StatusCode == nil {
+		return 0, &wrappedError
+	}
+	return *wrappedError.StatusCode, &wrappedError
+}
+
+// isNotFoundError returns true if the API responded with 404 Not Found.
+func isNotFoundError(err error) bool {
+	statusCode, _ := getWrappedErrorStatus(err)
+	return statusCode == http.StatusNotFound
+}
+
+// sendProjectRequest sends a GET request to an API of the project that has no
+// client in the SDK, e.g. path "_apis/pipelines/approvals".
+func sendProjectRequest(ctx context.Context, connection *azuredevops.Connection, projectId string, path string, apiVersion string, queryParams url.Values) (*http.Response, error) {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	requestUrl := strings.TrimSuffix(connection.BaseUrl, "/") + "/" + url.PathEscape(projectId) + "/" + path
+	if len(queryParams) > 0 {
+		requestUrl += "?" + queryParams.Encode()
+	}
+
+	req, err := client.CreateRequestMessage(ctx, http.MethodGet, requestUrl, apiVersion, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.SendRequest(req)
+}
+
+// getQualIntValues returns the values of an equals qual, which is a list for
+// "in (...)" quals.
+func getQualIntValues(value *proto.QualValue) []int {
+	if value == nil {
+		return nil
+	}
+	if list := value.GetListValue(); list != nil {
+		var values []int
+		for _, v := range list.Values {
+			values = append(values, int(v.GetInt64Value()))
+		}
+		return values
+	}
+	return []int{int(value.GetInt64Value())}
+}
+
+// if the caching is required other than per connection, build a cache key for the call and use it in Memoize.
+var getCollectionIdMemoized = plugin.HydrateFunc(getCollectionIdUncached).Memoize(memoize.WithCacheKeyFunction(getCollectionIdCacheKey))
+
+// getCollectionId returns the ID of the organization (project collection) of the connection.
+func getCollectionId(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getCollectionIdMemoized(ctx, d, h)
+}
+
+// Build a cache key for the call to getCollectionIdCacheKey.
+func getCollectionIdCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := "getCollectionId"
+	return key, nil
+}
+
+func getCollectionIdUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	connection, err := getConnection(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCollectionIdUncached", "connection_error", err)
+		return nil, err
+	}
+	client := location.NewClient(ctx, connection)
+
+	connectionData, err := client.GetConnectionData(ctx, location.GetConnectionDataArgs{})
+	if err != nil {
+		plugin.Logger(ctx).Error("getCollectionIdUncached", "api_error", err)
+		return nil, err
+	}
+	if connectionData.InstanceId == nil {
+		return nil, errors.New("unable to determine the organization ID")
+	}
+
+	return *connectionData.InstanceId, nil
+}
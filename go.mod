@@ -9,6 +9,7 @@ require (
 	github.com/microsoft/azure-devops-go-api/azuredevops/v6 v6.0.1
 	github.com/turbot/go-kit v1.1.0
 	github.com/turbot/steampipe-plugin-sdk/v5 v5.13.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (